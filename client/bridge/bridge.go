@@ -2,9 +2,11 @@ package bridge
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strings"
@@ -12,20 +14,29 @@ import (
 
 	"github.com/bytedance/sonic"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ybina/polymarket-go/client/retry"
+	"github.com/ybina/polymarket-go/client/types"
+	"github.com/ybina/polymarket-go/tools/logging"
 )
 
 const defaultBridgeBaseURL = "https://bridge.polymarket.com"
 
 // BridgeClient represents Polymarket Bridge client
 type BridgeClient struct {
-	host       string
-	httpClient *http.Client
+	host        string
+	httpClient  *http.Client
+	retryPolicy *retry.Policy
+	logger      *slog.Logger
 }
 
 type ClientConfig struct {
 	Host     string
 	Timeout  time.Duration
 	ProxyUrl string
+	// RetryPolicy is applied to every request; nil uses retry.DefaultPolicy().
+	RetryPolicy *retry.Policy
+	// Logger receives the client logs; nil uses slog.Default(). Credentials are redacted.
+	Logger *slog.Logger
 }
 
 // NewBridgeClient creates a BridgeClient with optional proxy and timeout.
@@ -48,6 +59,14 @@ func NewBridgeClient(cfg *ClientConfig) (*BridgeClient, error) {
 		httpClient: &http.Client{
 			Timeout: timeout,
 		},
+		retryPolicy: retry.DefaultPolicy(),
+		logger:      logging.New(nil),
+	}
+	if cfg != nil && cfg.RetryPolicy != nil {
+		c.retryPolicy = cfg.RetryPolicy
+	}
+	if cfg != nil && cfg.Logger != nil {
+		c.logger = logging.New(cfg.Logger)
 	}
 
 	if cfg != nil && strings.TrimSpace(cfg.ProxyUrl) != "" {
@@ -141,60 +160,67 @@ type QuoteResponse struct {
 	QuoteID            string       `json:"quoteId"`
 }
 
+// ErrResp is the error body of the bridge API.
+//
+// Deprecated: errors are returned as *types.APIError.
 type ErrResp struct {
 	Error   string `json:"error"`
 	Message string `json:"message"`
 }
 
 func (c *BridgeClient) doJSON(method, endpoint string, data interface{}, expectedStatus int, result interface{}) error {
-	var bodyReader io.Reader
+	var body []byte
 	if data != nil {
 		switch v := data.(type) {
 		case string:
-			bodyReader = strings.NewReader(v)
+			body = []byte(v)
 		case []byte:
-			bodyReader = bytes.NewReader(v)
+			body = v
 		default:
 			b, err := sonic.Marshal(v)
 			if err != nil {
 				return fmt.Errorf("failed to marshal request data: %w", err)
 			}
-			bodyReader = bytes.NewReader(b)
+			body = b
 		}
 	}
 
-	req, err := http.NewRequest(method, c.host+endpoint, bodyReader)
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
-	}
+	var raw []byte
+	err := c.retryPolicy.Do(context.Background(), method == http.MethodGet, func() error {
+		var bodyReader io.Reader
+		if body != nil {
+			bodyReader = bytes.NewReader(body)
+		}
+		req, err := http.NewRequest(method, c.host+endpoint, bodyReader)
+		if err != nil {
+			return fmt.Errorf("failed to create request: %w", err)
+		}
 
-	req.Header.Set("Accept", "application/json")
-	if data != nil {
-		req.Header.Set("Content-Type", "application/json")
-	}
+		req.Header.Set("Accept", "application/json")
+		if data != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to make request: %w", err)
-	}
-	defer resp.Body.Close()
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			c.logger.Debug("bridge request failed", "method", method, "endpoint", endpoint, "error", err)
+			return fmt.Errorf("failed to make request: %w", err)
+		}
+		defer resp.Body.Close()
+		c.logger.Debug("bridge request", "method", method, "endpoint", endpoint, "status", resp.StatusCode)
 
-	raw, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return fmt.Errorf("failed to read response body: %w", err)
-	}
+		raw, err = io.ReadAll(resp.Body)
+		if err != nil {
+			return fmt.Errorf("failed to read response body: %w", err)
+		}
 
-	if resp.StatusCode != expectedStatus {
-		er := &ErrResp{}
-		if e := sonic.Unmarshal(raw, er); e == nil {
-			if strings.TrimSpace(er.Error) != "" {
-				return fmt.Errorf("%s", er.Error)
-			}
-			if strings.TrimSpace(er.Message) != "" {
-				return fmt.Errorf("%s", er.Message)
-			}
+		if resp.StatusCode != expectedStatus {
+			return types.NewAPIError(resp, raw)
 		}
-		return fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(raw))
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	if result == nil {
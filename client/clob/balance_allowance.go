@@ -0,0 +1,91 @@
+package clob
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/shopspring/decimal"
+	"github.com/ybina/polymarket-go/client/endpoint"
+	"github.com/ybina/polymarket-go/client/types"
+)
+
+// collateralDecimals is the number of decimals of USDC and of conditional tokens.
+const collateralDecimals = 6
+
+// GetBalanceAllowance returns the balance and allowance of the funder for collateral or
+// for the conditional token params.TokenID. signerAddr is the address the API key belongs
+// to; for a PrivateKey signer it may be left zero.
+func (c *ClobClient) GetBalanceAllowance(params types.BalanceAllowanceParams, signerAddr common.Address) (*types.BalanceAllowance, error) {
+	return c.GetBalanceAllowanceWithContext(context.Background(), params, signerAddr)
+}
+
+func (c *ClobClient) GetBalanceAllowanceWithContext(ctx context.Context, params types.BalanceAllowanceParams, signerAddr common.Address) (*types.BalanceAllowance, error) {
+	var result types.BalanceAllowanceResponse
+	if err := c.balanceAllowanceRequest(ctx, endpoint.GetBalanceAllowance, params, signerAddr, &result); err != nil {
+		return nil, err
+	}
+	return scaleBalanceAllowance(result)
+}
+
+// UpdateBalanceAllowance asks the exchange to refresh its view of the funder's on-chain
+// balance and allowance, e.g. after a deposit or an approval.
+func (c *ClobClient) UpdateBalanceAllowance(params types.BalanceAllowanceParams, signerAddr common.Address) error {
+	return c.UpdateBalanceAllowanceWithContext(context.Background(), params, signerAddr)
+}
+
+func (c *ClobClient) UpdateBalanceAllowanceWithContext(ctx context.Context, params types.BalanceAllowanceParams, signerAddr common.Address) error {
+	return c.balanceAllowanceRequest(ctx, endpoint.UpdateBalanceAllowance, params, signerAddr, nil)
+}
+
+func (c *ClobClient) balanceAllowanceRequest(ctx context.Context, path string, params types.BalanceAllowanceParams, signerAddr common.Address, result interface{}) error {
+	queryParams := url.Values{}
+	switch params.AssetType {
+	case types.AssetTypeCollateral:
+	case types.AssetTypeConditional:
+		if params.TokenID == nil || *params.TokenID == "" {
+			return fmt.Errorf("token id is required for %s balance", params.AssetType)
+		}
+		queryParams.Add("token_id", *params.TokenID)
+	default:
+		return fmt.Errorf("invalid asset type: %q", params.AssetType)
+	}
+	queryParams.Add("asset_type", string(params.AssetType))
+	queryParams.Add("signature_type", strconv.Itoa(int(c.signatureType())))
+	return c.l2Get(ctx, path, signerAddr, queryParams, result)
+}
+
+func scaleBalanceAllowance(resp types.BalanceAllowanceResponse) (*types.BalanceAllowance, error) {
+	parse := func(name, v string) (decimal.Decimal, error) {
+		if v == "" {
+			return decimal.Zero, nil
+		}
+		d, err := decimal.NewFromString(v)
+		if err != nil {
+			return decimal.Decimal{}, fmt.Errorf("invalid %s %q: %w", name, v, err)
+		}
+		return d.Shift(-collateralDecimals), nil
+	}
+	balance, err := parse("balance", resp.Balance)
+	if err != nil {
+		return nil, err
+	}
+	allowance, err := parse("allowance", resp.Allowance)
+	if err != nil {
+		return nil, err
+	}
+	result := &types.BalanceAllowance{Balance: balance, Allowance: allowance}
+	if len(resp.Allowances) > 0 {
+		result.Allowances = make(map[string]decimal.Decimal, len(resp.Allowances))
+		for spender, v := range resp.Allowances {
+			a, err := parse("allowance", v)
+			if err != nil {
+				return nil, err
+			}
+			result.Allowances[spender] = a
+		}
+	}
+	return result, nil
+}
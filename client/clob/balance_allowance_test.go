@@ -0,0 +1,54 @@
+package clob
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/shopspring/decimal"
+	"github.com/ybina/polymarket-go/client/types"
+)
+
+func TestClobClient_GetBalanceAllowance(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.Header.Get("POLY_API_KEY") != "test-key" || q.Get("signature_type") != "0" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/balance-allowance":
+			if q.Get("asset_type") != "CONDITIONAL" || q.Get("token_id") != "1" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			_, _ = w.Write([]byte(`{"balance":"12345678","allowances":{"0x4bFb41d5B3570DeFd03C39a9A4D8dE6Bd8B8982E":"1000000"}}`))
+		case "/balance-allowance/update":
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	clobClient := newTestPrivateKeyClient(t, srv.URL)
+	tokenID := "1"
+	params := types.BalanceAllowanceParams{AssetType: types.AssetTypeConditional, TokenID: &tokenID}
+	ba, err := clobClient.GetBalanceAllowance(params, common.Address{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ba.Balance.Equal(decimal.RequireFromString("12.345678")) {
+		t.Fatalf("unexpected balance: %s", ba.Balance)
+	}
+	if !ba.Allowances["0x4bFb41d5B3570DeFd03C39a9A4D8dE6Bd8B8982E"].Equal(decimal.NewFromInt(1)) {
+		t.Fatalf("unexpected allowances: %v", ba.Allowances)
+	}
+	if err := clobClient.UpdateBalanceAllowance(params, common.Address{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := clobClient.GetBalanceAllowance(types.BalanceAllowanceParams{AssetType: types.AssetTypeConditional}, common.Address{}); err == nil {
+		t.Fatal("expected error without token id")
+	}
+}
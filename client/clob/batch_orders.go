@@ -0,0 +1,252 @@
+package clob
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/bytedance/sonic"
+	"github.com/ybina/polymarket-go/client/clob/clob_types"
+	"github.com/ybina/polymarket-go/client/clob/utils_order_builder"
+	"github.com/ybina/polymarket-go/client/endpoint"
+	"github.com/ybina/polymarket-go/client/types"
+)
+
+// MaxBatchOrders is the largest number of orders the exchange accepts in one /orders request.
+const MaxBatchOrders = 15
+
+// batchConcurrency bounds the parallel market lookups and signatures of a batch.
+const batchConcurrency = 8
+
+// BatchOrderResult is the outcome of one order of a batch. Index is the position
+// of the order in the args passed to CreateAndPostOrders. Err is set when the order
+// could not be signed, was rejected by the exchange or the batch request failed.
+type BatchOrderResult struct {
+	Index    int                              `json:"index"`
+	Order    *utils_order_builder.SignedOrder `json:"order,omitempty"`
+	Response *types.OrderResponse             `json:"response,omitempty"`
+	Err      error                            `json:"-"`
+}
+
+type batchMarketInfo struct {
+	tickSize   types.TickSize
+	negRisk    bool
+	feeRateBps int
+	err        error
+}
+
+// CreateAndPostOrders signs every order of args and posts them in a single /orders request.
+// TickSize and NegRisk from option apply to every order; when unset they are looked up once per token.
+func (c *ClobClient) CreateAndPostOrders(args []clob_types.OrderArgs, option clob_types.PartialCreateOrderOptions) ([]BatchOrderResult, error) {
+	return c.CreateAndPostOrdersWithContext(context.Background(), args, option)
+}
+
+func (c *ClobClient) CreateAndPostOrdersWithContext(ctx context.Context, args []clob_types.OrderArgs, option clob_types.PartialCreateOrderOptions) ([]BatchOrderResult, error) {
+	if len(args) == 0 {
+		return nil, fmt.Errorf("no orders to post")
+	}
+	if len(args) > MaxBatchOrders {
+		return nil, fmt.Errorf("too many orders in batch: %d, max: %d", len(args), MaxBatchOrders)
+	}
+	if err := c.AssertL2Auth(); err != nil {
+		return nil, err
+	}
+	if err := c.assertTradingAccounts(option); err != nil {
+		return nil, err
+	}
+
+	results, err := c.createOrders(ctx, args, option)
+	if err != nil {
+		return nil, err
+	}
+
+	var signed []int
+	for i := range results {
+		if results[i].Err == nil {
+			signed = append(signed, i)
+		}
+	}
+	if len(signed) == 0 {
+		return results, nil
+	}
+
+	orders := make([]utils_order_builder.SignedOrder, len(signed))
+	for j, i := range signed {
+		orders[j] = *results[i].Order
+	}
+	responses, err := c.postOrders(ctx, orders, option)
+	if err != nil {
+		for _, i := range signed {
+			results[i].Err = err
+		}
+		return results, err
+	}
+
+	applyBatchResponses(results, signed, responses)
+	return results, nil
+}
+
+// applyBatchResponses sets the responses of the posted orders, whose indexes in
+// results are listed by posted. The exchange answers in request order.
+func applyBatchResponses(results []BatchOrderResult, posted []int, responses []types.OrderResponse) {
+	for j, i := range posted {
+		if j >= len(responses) {
+			results[i].Err = fmt.Errorf("no response for order")
+			continue
+		}
+		resp := responses[j]
+		results[i].Response = &resp
+		if !resp.Success || resp.ErrorMsg != "" {
+			results[i].Err = fmt.Errorf("order rejected: %s", resp.ErrorMsg)
+		}
+	}
+}
+
+// createOrders resolves market parameters once per token and signs the orders concurrently.
+func (c *ClobClient) createOrders(ctx context.Context, args []clob_types.OrderArgs, option clob_types.PartialCreateOrderOptions) ([]BatchOrderResult, error) {
+	if err := c.AssertL1Auth(); err != nil {
+		return nil, err
+	}
+	orderBuilder, err := c.newOrderBuilder(option)
+	if err != nil {
+		return nil, err
+	}
+
+	markets := make(map[string]*batchMarketInfo)
+	for _, a := range args {
+		markets[a.TokenID] = &batchMarketInfo{}
+	}
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, batchConcurrency)
+	for tokenID, info := range markets {
+		wg.Add(1)
+		go func(tokenID string, info *batchMarketInfo) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			c.resolveBatchMarketInfo(ctx, tokenID, option, info)
+		}(tokenID, info)
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	results := make([]BatchOrderResult, len(args))
+	for i := range args {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			results[i].Index = i
+			a := args[i]
+			info := markets[a.TokenID]
+			if info.err != nil {
+				results[i].Err = info.err
+				return
+			}
+			vctx := c.preflightContext(a.TokenID, info.tickSize)
+			if err := preflightError(ValidateOrder(a, option, vctx)); err != nil {
+				results[i].Err = err
+				return
+			}
+			if err := checkFeeRate(info.feeRateBps, a.FeeRateBps); err != nil {
+				results[i].Err = err
+				return
+			}
+			a.FeeRateBps = info.feeRateBps
+			if err := ctx.Err(); err != nil {
+				results[i].Err = err
+				return
+			}
+
+			opt := option
+			opt.TickSize = &info.tickSize
+			opt.NegRisk = &info.negRisk
+			order, err := orderBuilder.CreateOrder(c.signer, a, opt)
+			if err == nil {
+				err = c.verifySignedOrder(order, opt, a.Price)
+			}
+			if err != nil {
+				results[i].Err = err
+				return
+			}
+			results[i].Order = &order
+		}(i)
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (c *ClobClient) resolveBatchMarketInfo(ctx context.Context, tokenID string, option clob_types.PartialCreateOrderOptions, info *batchMarketInfo) {
+	if option.TickSize != nil {
+		info.tickSize = *option.TickSize
+	} else {
+		info.tickSize, info.err = c.GetTickSizeWithContext(ctx, tokenID)
+		if info.err != nil {
+			return
+		}
+	}
+	if option.NegRisk != nil {
+		info.negRisk = *option.NegRisk
+	} else {
+		info.negRisk, info.err = c.GetNegRiskWithContext(ctx, tokenID)
+		if info.err != nil {
+			return
+		}
+	}
+	info.feeRateBps, info.err = c.GetFeeRateBpsWithContext(ctx, tokenID)
+}
+
+// postOrders posts already signed orders to /orders with L2 (and builder) headers.
+func (c *ClobClient) postOrders(ctx context.Context, orders []utils_order_builder.SignedOrder, option clob_types.PartialCreateOrderOptions) ([]types.OrderResponse, error) {
+	if err := c.AssertL2Auth(); err != nil {
+		return nil, err
+	}
+	if option.OrderType == "" {
+		option.OrderType = types.OrderTypeGTC
+	}
+	body := make([]*FinalBody, len(orders))
+	for i, order := range orders {
+		b, err := c.orderToBody(order, c.apiCreds(), option)
+		if err != nil {
+			return nil, err
+		}
+		body[i] = b
+	}
+	return c.postOrderBodies(ctx, body, option)
+}
+
+// postOrderBodies posts order bodies to /orders with L2 (and builder) headers.
+func (c *ClobClient) postOrderBodies(ctx context.Context, body []*FinalBody, option clob_types.PartialCreateOrderOptions) ([]types.OrderResponse, error) {
+	bodyStr, err := sonic.MarshalString(body)
+	if err != nil {
+		return nil, err
+	}
+	serializedBody, err := serializeJsonBody(body)
+	if err != nil {
+		return nil, err
+	}
+
+	requestArgs := &types.L2HeaderArgs{
+		Method:         "POST",
+		RequestPath:    endpoint.PostOrders,
+		Body:           bodyStr,
+		SerializedBody: serializedBody,
+	}
+	reqHeaders, err := c.tradingHeaders(ctx, requestArgs, option)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []types.OrderResponse
+	if err := c.postJSONWithHeaders(ctx, endpoint.PostOrders, reqHeaders, serializedBody, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,153 @@
+package clob
+
+import (
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/bytedance/sonic"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/shopspring/decimal"
+	"github.com/ybina/polymarket-go/client/clob/clob_types"
+	"github.com/ybina/polymarket-go/client/signer"
+	"github.com/ybina/polymarket-go/client/types"
+)
+
+func newTestPrivateKeyClient(t *testing.T, host string) *ClobClient {
+	t.Helper()
+	pk, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	signerHandler, err := signer.NewSigner(signer.SignerConfig{
+		SignerType:       signer.PrivateKey,
+		ChainID:          137,
+		PrivateKeyConfig: &signer.PrivateKeyClient{PrivateKey: pk},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	clobClient, err := NewClobClient(&ClientConfig{
+		Host:    host,
+		ChainID: types.ChainPolygon,
+		Signer:  signerHandler,
+		APIKey: &types.ApiKeyCreds{
+			Key:        "test-key",
+			Secret:     base64.URLEncoding.EncodeToString([]byte("test-secret")),
+			Passphrase: "test-passphrase",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return clobClient
+}
+
+// fakeExchange serves /time, /fee-rate and the fixed responses of a test, and records the
+// orders posted to /order and /orders with the API key of newTestPrivateKeyClient.
+type fakeExchange struct {
+	*httptest.Server
+	mu     sync.Mutex
+	posted []FinalBody
+}
+
+func newFakeExchange(t *testing.T, responses map[string]string) *fakeExchange {
+	t.Helper()
+	f := &fakeExchange{}
+	f.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		switch r.URL.Path {
+		case "/time":
+			_, _ = w.Write([]byte("1700000000"))
+			return
+		case "/fee-rate":
+			_, _ = w.Write([]byte(`{"base_fee":0}`))
+			return
+		case "/order", "/orders":
+			if r.Header.Get("POLY_API_KEY") != "test-key" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			body, _ := io.ReadAll(r.Body)
+			f.posted = nil
+			var err error
+			if r.URL.Path == "/order" {
+				var b FinalBody
+				err = sonic.Unmarshal(body, &b)
+				f.posted = append(f.posted, b)
+			} else {
+				err = sonic.Unmarshal(body, &f.posted)
+			}
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+		}
+		resp, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(resp))
+	}))
+	t.Cleanup(f.Close)
+	return f
+}
+
+// Posted returns the orders of the last /order or /orders request.
+func (f *fakeExchange) Posted() []FinalBody {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.posted
+}
+
+// testOrderOptions sets the tick size and neg risk flag, so that creating an order
+// needs no market lookups.
+func testOrderOptions(orderType types.OrderType) clob_types.PartialCreateOrderOptions {
+	tickSize := types.TickSize001
+	negRisk := false
+	return clob_types.PartialCreateOrderOptions{OrderType: orderType, TickSize: &tickSize, NegRisk: &negRisk}
+}
+
+func TestClobClient_CreateAndPostOrders(t *testing.T) {
+	exchange := newFakeExchange(t, map[string]string{
+		"/orders": `[{"success":true,"orderID":"0x1","status":"live"},{"success":false,"errorMsg":"not enough balance / allowance"}]`,
+	})
+
+	clobClient := newTestPrivateKeyClient(t, exchange.URL)
+	args := []clob_types.OrderArgs{
+		{TokenID: "1", Price: decimal.RequireFromString("0.45"), Size: decimal.NewFromInt(10), Side: types.SideBuy},
+		{TokenID: "1", Price: decimal.RequireFromString("1.5"), Size: decimal.NewFromInt(10), Side: types.SideBuy},
+		{TokenID: "2", Price: decimal.RequireFromString("0.55"), Size: decimal.NewFromInt(10), Side: types.SideSell},
+	}
+	results, err := clobClient.CreateAndPostOrders(args, testOrderOptions(types.OrderTypeGTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	posted := exchange.Posted()
+	if len(posted) != 2 {
+		t.Fatalf("expected 2 posted orders, got %d", len(posted))
+	}
+	if posted[1].Order.TokenID != "2" || posted[1].Owner != "test-key" {
+		t.Fatalf("unexpected posted order: %+v", posted[1])
+	}
+	if results[0].Err != nil || results[0].Response.OrderID != "0x1" {
+		t.Fatalf("order 0: %+v", results[0])
+	}
+	if results[1].Err == nil || results[1].Response != nil {
+		t.Fatalf("order 1 should fail client side: %+v", results[1])
+	}
+	if results[2].Err == nil || !strings.Contains(results[2].Err.Error(), "not enough balance") {
+		t.Fatalf("order 2 should be rejected by the exchange: %+v", results[2])
+	}
+	for i, r := range results {
+		if r.Index != i {
+			t.Fatalf("result %d has index %d", i, r.Index)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package clob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/shopspring/decimal"
+	"github.com/ybina/polymarket-go/client/constants"
+	"github.com/ybina/polymarket-go/client/endpoint"
+	"github.com/ybina/polymarket-go/client/types"
+)
+
+// GetBuilderTrades returns every trade attributed to the configured builder key matching params.
+// params may be nil.
+func (c *ClobClient) GetBuilderTrades(params *types.BuilderTradeParams) ([]types.BuilderTrade, error) {
+	return c.GetBuilderTradesWithContext(context.Background(), params)
+}
+
+func (c *ClobClient) GetBuilderTradesWithContext(ctx context.Context, params *types.BuilderTradeParams) ([]types.BuilderTrade, error) {
+	return c.IterBuilderTrades(ctx, params).collect()
+}
+
+func (c *ClobClient) IterBuilderTrades(ctx context.Context, params *types.BuilderTradeParams) *Iterator[types.BuilderTrade] {
+	return newIterator(ctx, types.INITIAL_CURSOR, func(ctx context.Context, cursor string) ([]types.BuilderTrade, string, error) {
+		return c.GetBuilderTradesPage(ctx, params, cursor)
+	})
+}
+
+// GetBuilderTradesPage fetches a single page of builder trades and returns it with the next cursor.
+func (c *ClobClient) GetBuilderTradesPage(ctx context.Context, params *types.BuilderTradeParams, nextCursor string) ([]types.BuilderTrade, string, error) {
+	if !c.canBuilderAuth() {
+		return nil, "", errors.New(constants.BUILDER_AUTH_UNAVAILABLE)
+	}
+	builderHeaders, err := c.builderConfig.GenerateBuilderHeaders("GET", endpoint.GetBuilderTrades, nil, c.headerTimestamp(ctx))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create builder headers: %w", err)
+	}
+
+	queryParams := url.Values{}
+	if params != nil {
+		if params.ID != nil {
+			queryParams.Add("id", *params.ID)
+		}
+		if params.Market != nil {
+			queryParams.Add("market", *params.Market)
+		}
+		if params.AssetID != nil {
+			queryParams.Add("asset_id", *params.AssetID)
+		}
+		if params.Before != nil {
+			queryParams.Add("before", *params.Before)
+		}
+		if params.After != nil {
+			queryParams.Add("after", *params.After)
+		}
+	}
+	var result pageResponse[types.BuilderTrade]
+	if err := c.getJSONWithHeadersAndParams(ctx, endpoint.GetBuilderTrades, builderHeaders, withCursor(queryParams, nextCursor), &result); err != nil {
+		return nil, "", err
+	}
+	return result.Data, result.NextCursor, nil
+}
+
+// GetBuilderTradesSummary fetches the builder trades matching params and aggregates them.
+func (c *ClobClient) GetBuilderTradesSummary(params *types.BuilderTradeParams) (*types.BuilderTradesSummary, error) {
+	return c.GetBuilderTradesSummaryWithContext(context.Background(), params)
+}
+
+func (c *ClobClient) GetBuilderTradesSummaryWithContext(ctx context.Context, params *types.BuilderTradeParams) (*types.BuilderTradesSummary, error) {
+	trades, err := c.GetBuilderTradesWithContext(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return SummarizeBuilderTrades(trades)
+}
+
+// SummarizeBuilderTrades sums the USDC volume and fees of trades, in total and per market.
+func SummarizeBuilderTrades(trades []types.BuilderTrade) (*types.BuilderTradesSummary, error) {
+	summary := &types.BuilderTradesSummary{ByMarket: make(map[string]*types.BuilderMarketSummary)}
+	for _, t := range trades {
+		if strings.EqualFold(t.Status, "FAILED") {
+			summary.Failed++
+			continue
+		}
+		volume, err := decimalOrZero(t.SizeUSDC)
+		if err != nil {
+			return nil, fmt.Errorf("trade %s: invalid sizeUsdc: %w", t.ID, err)
+		}
+		fee, err := decimalOrZero(t.FeeUSDC)
+		if err != nil {
+			return nil, fmt.Errorf("trade %s: invalid feeUsdc: %w", t.ID, err)
+		}
+		summary.Trades++
+		summary.Volume = summary.Volume.Add(volume)
+		summary.Fees = summary.Fees.Add(fee)
+
+		m, ok := summary.ByMarket[t.Market]
+		if !ok {
+			m = &types.BuilderMarketSummary{}
+			summary.ByMarket[t.Market] = m
+		}
+		m.Trades++
+		m.Volume = m.Volume.Add(volume)
+		m.Fees = m.Fees.Add(fee)
+	}
+	return summary, nil
+}
+
+func decimalOrZero(v string) (decimal.Decimal, error) {
+	if v == "" {
+		return decimal.Zero, nil
+	}
+	return decimal.NewFromString(v)
+}
@@ -0,0 +1,62 @@
+package clob
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/ybina/polymarket-go/client/types"
+	"github.com/ybina/polymarket-go/tools/headers"
+)
+
+func TestClobClient_GetBuilderTrades(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/builder/trades" || r.Header.Get("POLY_BUILDER_API_KEY") != "builder-key" || r.Header.Get("POLY_BUILDER_SIGNATURE") == "" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.Header.Get("POLY_API_KEY") != "" || r.URL.Query().Get("market") != "0xabc" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if r.URL.Query().Get("next_cursor") == types.INITIAL_CURSOR {
+			_, _ = w.Write([]byte(`{"next_cursor":"MQ==","data":[{"id":"1","market":"0xabc","status":"CONFIRMED","sizeUsdc":"100.5","feeUsdc":"0.5"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"next_cursor":"LTE=","data":[{"id":"2","market":"0xabc","status":"MINED","sizeUsdc":"20","feeUsdc":"0.1"},{"id":"3","market":"0xabc","status":"FAILED","sizeUsdc":"50","feeUsdc":"1"}]}`))
+	}))
+	defer srv.Close()
+
+	clobClient, err := NewClobClient(&ClientConfig{
+		Host:    srv.URL,
+		ChainID: types.ChainPolygon,
+		BuilderConfig: &headers.BuilderConfig{
+			APIKey:     "builder-key",
+			Secret:     "YnVpbGRlci1zZWNyZXQ=",
+			Passphrase: "builder-passphrase",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	market := "0xabc"
+	summary, err := clobClient.GetBuilderTradesSummary(&types.BuilderTradeParams{Market: &market})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary.Trades != 2 || summary.Failed != 1 {
+		t.Fatalf("unexpected counts: %+v", summary)
+	}
+	if !summary.Volume.Equal(decimal.RequireFromString("120.5")) || !summary.ByMarket["0xabc"].Fees.Equal(decimal.RequireFromString("0.6")) {
+		t.Fatalf("unexpected aggregates: %+v", summary)
+	}
+
+	noBuilder, err := NewClobClient(&ClientConfig{Host: srv.URL, ChainID: types.ChainPolygon})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := noBuilder.GetBuilderTrades(nil); err == nil {
+		t.Fatal("expected builder auth error")
+	}
+}
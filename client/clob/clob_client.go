@@ -2,15 +2,17 @@ package clob
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/bytedance/sonic"
@@ -22,9 +24,11 @@ import (
 	"github.com/ybina/polymarket-go/client/config"
 	"github.com/ybina/polymarket-go/client/constants"
 	"github.com/ybina/polymarket-go/client/endpoint"
+	"github.com/ybina/polymarket-go/client/retry"
 	"github.com/ybina/polymarket-go/client/signer"
 	"github.com/ybina/polymarket-go/client/types"
 	"github.com/ybina/polymarket-go/tools/headers"
+	"github.com/ybina/polymarket-go/tools/logging"
 )
 
 // ClobClient represents CLOB client
@@ -32,12 +36,19 @@ type ClobClient struct {
 	host           string
 	chainID        types.Chain
 	signer         *signer.Signer
-	creds          *types.ApiKeyCreds
+	creds          atomic.Pointer[types.ApiKeyCreds]
 	builderConfig  *headers.BuilderConfig
 	geoBlockToken  string
 	useServerTime  bool
 	httpClient     *http.Client
 	contractConfig config.ContractConfig
+	metadata       *metadataCache
+	credStore      CredentialStore
+	retryPolicy    *retry.Policy
+	rateLimiter    *rateLimiter
+	clock          *headers.ClockSync
+	logger         *slog.Logger
+	verifyOrders   bool
 }
 
 type ClientConfig struct {
@@ -46,10 +57,29 @@ type ClientConfig struct {
 	APIKey        *types.ApiKeyCreds
 	BuilderConfig *headers.BuilderConfig
 	GeoBlockToken string
+	// UseServerTime signs every request with the server clock, synced every
+	// ClockSyncInterval. Without it, order and cancel requests fetch the server time
+	// before signing and other requests use the local clock.
 	UseServerTime bool
 	Timeout       time.Duration
 	ProxyUrl      string
 	Signer        *signer.Signer
+	// MetadataCache enables caching of tick sizes, neg-risk flags and fee rates; nil disables it.
+	MetadataCache *MetadataCacheConfig
+	// CredentialStore is used by CreateOrDeriveApiKey to reuse credentials across restarts.
+	CredentialStore CredentialStore
+	// RetryPolicy is applied to every request; nil uses retry.DefaultPolicy().
+	RetryPolicy *retry.Policy
+	// RateLimiter throttles requests per endpoint family before they are sent; nil disables it.
+	RateLimiter *RateLimiterConfig
+	// ClockSyncInterval is how often the server clock offset is measured when
+	// UseServerTime is set; zero uses headers.DefaultClockSyncInterval.
+	ClockSyncInterval time.Duration
+	// Logger receives the client logs; nil uses slog.Default(). Credentials are redacted.
+	Logger *slog.Logger
+	// VerifySignedOrders checks every order signed by the client with
+	// utils_order_builder.VerifySignedOrder before returning it.
+	VerifySignedOrders bool
 }
 
 func NewClobClient(config *ClientConfig) (*ClobClient, error) {
@@ -68,14 +98,30 @@ func NewClobClient(config *ClientConfig) (*ClobClient, error) {
 		host:          host,
 		chainID:       config.ChainID,
 		signer:        config.Signer,
-		creds:         config.APIKey,
 		builderConfig: config.BuilderConfig,
 		geoBlockToken: config.GeoBlockToken,
 		useServerTime: config.UseServerTime,
+		credStore:     config.CredentialStore,
+		retryPolicy:   config.RetryPolicy,
+		logger:        logging.New(config.Logger),
+		verifyOrders:  config.VerifySignedOrders,
 		httpClient: &http.Client{
 			Timeout: timeout,
 		},
 	}
+	client.creds.Store(config.APIKey)
+	if client.retryPolicy == nil {
+		client.retryPolicy = retry.DefaultPolicy()
+	}
+	if config.UseServerTime {
+		client.clock = headers.NewClockSync(client.GetServerTimeWithContext, config.ClockSyncInterval)
+	}
+	if config.RateLimiter != nil {
+		client.rateLimiter = newRateLimiter(*config.RateLimiter)
+	}
+	if config.MetadataCache != nil {
+		client.metadata = newMetadataCache(*config.MetadataCache)
+	}
 	if config.ProxyUrl != "" {
 		proxyUrl, err := url.Parse(config.ProxyUrl)
 		if err != nil {
@@ -90,58 +136,67 @@ func NewClobClient(config *ClientConfig) (*ClobClient, error) {
 }
 
 func (c *ClobClient) GetOK() (interface{}, error) {
-	return c.get("/")
+	return c.GetOKWithContext(context.Background())
+}
+
+func (c *ClobClient) GetOKWithContext(ctx context.Context) (interface{}, error) {
+	return c.get(ctx, "/")
 }
 
 func (c *ClobClient) GetServerTime() (int64, error) {
+	return c.GetServerTimeWithContext(context.Background())
+}
+
+func (c *ClobClient) GetServerTimeWithContext(ctx context.Context) (int64, error) {
 	var result int64
-	err := c.getJSON(endpoint.Time, &result)
+	err := c.getJSON(ctx, endpoint.Time, &result)
 
 	return result, err
 }
 
-func (c *ClobClient) GetSamplingSimplifiedMarkets(nextCursor string) (*types.PaginationPayload, error) {
-	params := url.Values{}
-	if nextCursor != "" {
-		params.Add("next_cursor", nextCursor)
-	}
-
-	var result types.PaginationPayload
-	err := c.getJSONWithParams(endpoint.GetSamplingSimplifiedMarkets, params, &result)
-	return &result, err
+func (c *ClobClient) GetMarket(conditionID string) (*types.Market, error) {
+	return c.GetMarketWithContext(context.Background(), conditionID)
 }
 
-func (c *ClobClient) GetMarkets(nextCursor string) (*types.PaginationPayload, error) {
-	params := url.Values{}
-	if nextCursor != "" {
-		params.Add("next_cursor", nextCursor)
+func (c *ClobClient) GetMarketWithContext(ctx context.Context, conditionID string) (*types.Market, error) {
+	var result types.Market
+	if err := c.getJSONWithParams(ctx, endpoint.GetMarket+conditionID, url.Values{}, &result); err != nil {
+		return nil, fmt.Errorf("failed to get market %s: %w", conditionID, err)
 	}
-
-	var result types.PaginationPayload
-	err := c.getJSONWithParams(endpoint.GetMarkets, params, &result)
-	return &result, err
+	return &result, nil
 }
 
-func (c *ClobClient) GetMarket(conditionID string) (interface{}, error) {
-	return c.get(endpoint.GetMarket + conditionID)
+func (c *ClobClient) GetOrderBook(tokenID string) (*types.OrderBookSummary, error) {
+	return c.GetOrderBookWithContext(context.Background(), tokenID)
 }
 
-func (c *ClobClient) GetOrderBook(tokenID string) (*types.OrderBookSummary, error) {
+func (c *ClobClient) GetOrderBookWithContext(ctx context.Context, tokenID string) (*types.OrderBookSummary, error) {
 	params := url.Values{}
 	params.Add("token_id", tokenID)
 
 	var result types.OrderBookSummary
-	err := c.getJSONWithParams(endpoint.GetOrderBook, params, &result)
+	err := c.getJSONWithParams(ctx, endpoint.GetOrderBook, params, &result)
 	return &result, err
 }
 
 func (c *ClobClient) GetOrderBooks(params []types.BookParams) ([]types.OrderBookSummary, error) {
+	return c.GetOrderBooksWithContext(context.Background(), params)
+}
+
+func (c *ClobClient) GetOrderBooksWithContext(ctx context.Context, params []types.BookParams) ([]types.OrderBookSummary, error) {
 	var result []types.OrderBookSummary
-	err := c.postJSON(endpoint.GetOrderBooks, params, &result)
+	err := c.postJSON(ctx, endpoint.GetOrderBooks, params, &result)
 	return result, err
 }
 
 func (c *ClobClient) GetTickSize(tokenID string) (types.TickSize, error) {
+	return c.GetTickSizeWithContext(context.Background(), tokenID)
+}
+
+func (c *ClobClient) GetTickSizeWithContext(ctx context.Context, tokenID string) (types.TickSize, error) {
+	if tickSize, ok := c.metadata.tickSize(tokenID); ok {
+		return tickSize, nil
+	}
 	params := url.Values{}
 	params.Add("token_id", tokenID)
 
@@ -149,14 +204,23 @@ func (c *ClobClient) GetTickSize(tokenID string) (types.TickSize, error) {
 		MinimumTickSize decimal.Decimal `json:"minimum_tick_size"`
 	}
 
-	err := c.getJSONWithParams(endpoint.GetTickSize, params, &result)
+	err := c.getJSONWithParams(ctx, endpoint.GetTickSize, params, &result)
 	if err != nil {
 		return "", err
 	}
-	return types.TickSize(result.MinimumTickSize.String()), nil
+	tickSize := types.TickSize(result.MinimumTickSize.String())
+	c.metadata.setTickSize(tokenID, tickSize)
+	return tickSize, nil
 }
 
 func (c *ClobClient) GetNegRisk(tokenID string) (bool, error) {
+	return c.GetNegRiskWithContext(context.Background(), tokenID)
+}
+
+func (c *ClobClient) GetNegRiskWithContext(ctx context.Context, tokenID string) (bool, error) {
+	if negRisk, ok := c.metadata.isNegRisk(tokenID); ok {
+		return negRisk, nil
+	}
 	params := url.Values{}
 	params.Add("token_id", tokenID)
 
@@ -164,11 +228,22 @@ func (c *ClobClient) GetNegRisk(tokenID string) (bool, error) {
 		NegRisk bool `json:"neg_risk"`
 	}
 
-	err := c.getJSONWithParams(endpoint.GetNegRisk, params, &result)
-	return result.NegRisk, err
+	err := c.getJSONWithParams(ctx, endpoint.GetNegRisk, params, &result)
+	if err != nil {
+		return false, err
+	}
+	c.metadata.setNegRisk(tokenID, result.NegRisk)
+	return result.NegRisk, nil
 }
 
 func (c *ClobClient) GetFeeRateBps(tokenID string) (int, error) {
+	return c.GetFeeRateBpsWithContext(context.Background(), tokenID)
+}
+
+func (c *ClobClient) GetFeeRateBpsWithContext(ctx context.Context, tokenID string) (int, error) {
+	if feeRate, ok := c.metadata.feeRate(tokenID); ok {
+		return feeRate, nil
+	}
 	params := url.Values{}
 	params.Add("token_id", tokenID)
 
@@ -176,38 +251,109 @@ func (c *ClobClient) GetFeeRateBps(tokenID string) (int, error) {
 		BaseFee int `json:"base_fee"`
 	}
 
-	err := c.getJSONWithParams(endpoint.GetFeeRate, params, &result)
-	return result.BaseFee, err
+	err := c.getJSONWithParams(ctx, endpoint.GetFeeRate, params, &result)
+	if err != nil {
+		return 0, err
+	}
+	c.metadata.setFeeRate(tokenID, result.BaseFee)
+	return result.BaseFee, nil
 }
 
 func (c *ClobClient) ResolveFeeRateBps(tokenID string, userFeeRate int) (int, error) {
-	marketFeeRateBps, err := c.GetFeeRateBps(tokenID)
+	return c.ResolveFeeRateBpsWithContext(context.Background(), tokenID, userFeeRate)
+}
+
+func (c *ClobClient) ResolveFeeRateBpsWithContext(ctx context.Context, tokenID string, userFeeRate int) (int, error) {
+	marketFeeRateBps, err := c.GetFeeRateBpsWithContext(ctx, tokenID)
 	if err != nil {
 		return 0, err
 	}
-	if marketFeeRateBps > 0 && userFeeRate > 0 && marketFeeRateBps != userFeeRate {
-		return 0, fmt.Errorf("invalid user provided fee rate: (%v), fee rate for the market must be %v", userFeeRate, marketFeeRateBps)
+	if err := checkFeeRate(marketFeeRateBps, userFeeRate); err != nil {
+		return 0, err
 	}
 	return marketFeeRateBps, nil
 }
 
-func (c *ClobClient) GetMidpoint(tokenID string) (interface{}, error) {
+func checkFeeRate(marketFeeRateBps int, userFeeRate int) error {
+	if marketFeeRateBps > 0 && userFeeRate > 0 && marketFeeRateBps != userFeeRate {
+		return fmt.Errorf("invalid user provided fee rate: (%v), fee rate for the market must be %v", userFeeRate, marketFeeRateBps)
+	}
+	return nil
+}
+
+func (c *ClobClient) GetMidpoint(tokenID string) (decimal.Decimal, error) {
+	return c.GetMidpointWithContext(context.Background(), tokenID)
+}
+
+func (c *ClobClient) GetMidpointWithContext(ctx context.Context, tokenID string) (decimal.Decimal, error) {
 	params := url.Values{}
 	params.Add("token_id", tokenID)
-	return c.getWithParams(endpoint.GetMidpoint, params)
+	var result struct {
+		Mid *decimal.Decimal `json:"mid"`
+	}
+	if err := c.getJSONWithParams(ctx, endpoint.GetMidpoint, params, &result); err != nil {
+		return decimal.Decimal{}, fmt.Errorf("failed to get midpoint of %s: %w", tokenID, err)
+	}
+	if result.Mid == nil {
+		return decimal.Decimal{}, fmt.Errorf("failed to get midpoint of %s: invalid response", tokenID)
+	}
+	return *result.Mid, nil
 }
 
-func (c *ClobClient) GetMidpoints(params []types.BookParams) (interface{}, error) {
-	var result interface{}
-	err := c.postJSON(endpoint.GetMidpoints, params, &result)
-	return result, err
+func (c *ClobClient) GetMidpoints(params []types.BookParams) (map[string]decimal.Decimal, error) {
+	return c.GetMidpointsWithContext(context.Background(), params)
+}
+
+// GetMidpointsWithContext returns the midpoints keyed by token id.
+func (c *ClobClient) GetMidpointsWithContext(ctx context.Context, params []types.BookParams) (map[string]decimal.Decimal, error) {
+	result := make(map[string]decimal.Decimal)
+	if err := c.postJSON(ctx, endpoint.GetMidpoints, params, &result); err != nil {
+		return nil, fmt.Errorf("failed to get midpoints: %w", err)
+	}
+	return result, nil
+}
+
+func (c *ClobClient) GetSpread(tokenID string) (decimal.Decimal, error) {
+	return c.GetSpreadWithContext(context.Background(), tokenID)
+}
+
+func (c *ClobClient) GetSpreadWithContext(ctx context.Context, tokenID string) (decimal.Decimal, error) {
+	params := url.Values{}
+	params.Add("token_id", tokenID)
+	var result struct {
+		Spread *decimal.Decimal `json:"spread"`
+	}
+	if err := c.getJSONWithParams(ctx, endpoint.GetSpread, params, &result); err != nil {
+		return decimal.Decimal{}, fmt.Errorf("failed to get spread of %s: %w", tokenID, err)
+	}
+	if result.Spread == nil {
+		return decimal.Decimal{}, fmt.Errorf("failed to get spread of %s: invalid response", tokenID)
+	}
+	return *result.Spread, nil
+}
+
+func (c *ClobClient) GetSpreads(params []types.BookParams) (map[string]decimal.Decimal, error) {
+	return c.GetSpreadsWithContext(context.Background(), params)
+}
+
+// GetSpreadsWithContext returns the spreads keyed by token id.
+func (c *ClobClient) GetSpreadsWithContext(ctx context.Context, params []types.BookParams) (map[string]decimal.Decimal, error) {
+	result := make(map[string]decimal.Decimal)
+	if err := c.postJSON(ctx, endpoint.GetSpreads, params, &result); err != nil {
+		return nil, fmt.Errorf("failed to get spreads: %w", err)
+	}
+	return result, nil
 }
 
 func (c *ClobClient) GetPrice(tokenID string, side types.Side) (decimal.Decimal, error) {
+	return c.GetPriceWithContext(context.Background(), tokenID, side)
+}
+
+func (c *ClobClient) GetPriceWithContext(ctx context.Context, tokenID string, side types.Side) (decimal.Decimal, error) {
 	params := url.Values{}
 	params.Add("token_id", tokenID)
 	params.Add("side", string(side))
-	res, err := c.getWithParams(endpoint.GetPrice, params)
+	res, err := c.getWithParams(ctx, endpoint.GetPrice, params)
 	if err != nil {
 		return decimal.Decimal{}, err
 	}
@@ -218,30 +364,61 @@ func (c *ClobClient) GetPrice(tokenID string, side types.Side) (decimal.Decimal,
 	}
 	p, ok := j["price"]
 	if !ok {
-		return decimal.Decimal{}, fmt.Errorf("invalid response")
+		return decimal.Decimal{}, fmt.Errorf("failed to get price of %s: invalid response", tokenID)
 	}
 	return p, nil
 }
 
-func (c *ClobClient) GetPrices(params []types.BookParams) (interface{}, error) {
-	var result interface{}
-	err := c.postJSON(endpoint.GetPrices, params, &result)
-	return result, err
+func (c *ClobClient) GetPrices(params []types.BookParams) (map[string]types.TokenPrices, error) {
+	return c.GetPricesWithContext(context.Background(), params)
 }
 
-func (c *ClobClient) GetLastTradePrice(tokenID string) (interface{}, error) {
+// GetPricesWithContext returns the prices keyed by token id, then by side.
+func (c *ClobClient) GetPricesWithContext(ctx context.Context, params []types.BookParams) (map[string]types.TokenPrices, error) {
+	result := make(map[string]types.TokenPrices)
+	if err := c.postJSON(ctx, endpoint.GetPrices, params, &result); err != nil {
+		return nil, fmt.Errorf("failed to get prices: %w", err)
+	}
+	return result, nil
+}
+
+func (c *ClobClient) GetLastTradePrice(tokenID string) (*types.LastTradePrice, error) {
+	return c.GetLastTradePriceWithContext(context.Background(), tokenID)
+}
+
+func (c *ClobClient) GetLastTradePriceWithContext(ctx context.Context, tokenID string) (*types.LastTradePrice, error) {
 	params := url.Values{}
 	params.Add("token_id", tokenID)
-	return c.getWithParams(endpoint.GetLastTradePrice, params)
+	var result types.LastTradePrice
+	if err := c.getJSONWithParams(ctx, endpoint.GetLastTradePrice, params, &result); err != nil {
+		return nil, fmt.Errorf("failed to get last trade price of %s: %w", tokenID, err)
+	}
+	result.TokenID = tokenID
+	return &result, nil
 }
 
-func (c *ClobClient) GetLastTradesPrices(params []types.BookParams) (interface{}, error) {
-	var result interface{}
-	err := c.postJSON(endpoint.GetLastTradesPrices, params, &result)
-	return result, err
+func (c *ClobClient) GetLastTradesPrices(params []types.BookParams) (map[string]types.LastTradePrice, error) {
+	return c.GetLastTradesPricesWithContext(context.Background(), params)
+}
+
+// GetLastTradesPricesWithContext returns the last trade prices keyed by token id.
+func (c *ClobClient) GetLastTradesPricesWithContext(ctx context.Context, params []types.BookParams) (map[string]types.LastTradePrice, error) {
+	var list []types.LastTradePrice
+	if err := c.postJSON(ctx, endpoint.GetLastTradesPrices, params, &list); err != nil {
+		return nil, fmt.Errorf("failed to get last trades prices: %w", err)
+	}
+	result := make(map[string]types.LastTradePrice, len(list))
+	for _, p := range list {
+		result[p.TokenID] = p
+	}
+	return result, nil
+}
+
+func (c *ClobClient) GetPricesHistory(params types.PriceHistoryFilterParams) ([]types.MarketPrice, error) {
+	return c.GetPricesHistoryWithContext(context.Background(), params)
 }
 
-func (c *ClobClient) GetPricesHistory(params types.PriceHistoryFilterParams) (interface{}, error) {
+func (c *ClobClient) GetPricesHistoryWithContext(ctx context.Context, params types.PriceHistoryFilterParams) ([]types.MarketPrice, error) {
 	queryParams := url.Values{}
 	if params.Market != nil {
 		queryParams.Add("market", *params.Market)
@@ -259,30 +436,30 @@ func (c *ClobClient) GetPricesHistory(params types.PriceHistoryFilterParams) (in
 		queryParams.Add("interval", string(*params.Interval))
 	}
 
-	return c.getWithParams(endpoint.GetPricesHistory, queryParams)
+	var result types.PricesHistory
+	if err := c.getJSONWithParams(ctx, endpoint.GetPricesHistory, queryParams, &result); err != nil {
+		return nil, fmt.Errorf("failed to get prices history: %w", err)
+	}
+	return result.History, nil
 }
 
 func (c *ClobClient) CreateApiKey(nonce *uint64, option clob_types.ClobOption) (*types.ApiKeyCreds, error) {
+	return c.CreateApiKeyWithContext(context.Background(), nonce, option)
+}
+
+func (c *ClobClient) CreateApiKeyWithContext(ctx context.Context, nonce *uint64, option clob_types.ClobOption) (*types.ApiKeyCreds, error) {
 	if c.signer == nil {
 		return nil, fmt.Errorf("signer is required to create API key")
 	}
 
-	var timestamp *int64
-	if c.useServerTime {
-		serverTime, err := c.GetServerTime()
-		if err != nil {
-			return nil, fmt.Errorf("failed to get server time: %w", err)
-		}
-		timestamp = &serverTime
-	}
-
-	l1Headers, err := headers.CreateL1Headers(c.signer, option, c.chainID, nonce, timestamp)
+	timestamp := c.headerTime(ctx)
+	l1Headers, err := headers.CreateL1Headers(c.signer, option, c.chainID, nonce, &timestamp)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create L1 headers: %w", err)
 	}
 
 	var apiKeyRaw types.ApiKeyRaw
-	err = c.postJSONWithHeaders(endpoint.CreateApiKey, l1Headers, nil, &apiKeyRaw)
+	err = c.postJSONWithHeaders(ctx, endpoint.CreateApiKey, l1Headers, nil, &apiKeyRaw)
 	if err != nil {
 		return nil, err
 	}
@@ -297,26 +474,22 @@ func (c *ClobClient) CreateApiKey(nonce *uint64, option clob_types.ClobOption) (
 }
 
 func (c *ClobClient) DeriveApiKey(nonce *uint64, option clob_types.ClobOption) (*types.ApiKeyCreds, error) {
+	return c.DeriveApiKeyWithContext(context.Background(), nonce, option)
+}
+
+func (c *ClobClient) DeriveApiKeyWithContext(ctx context.Context, nonce *uint64, option clob_types.ClobOption) (*types.ApiKeyCreds, error) {
 	if c.signer == nil {
 		return nil, fmt.Errorf("signer is required to derive API key")
 	}
 
-	var timestamp *int64
-	if c.useServerTime {
-		serverTime, err := c.GetServerTime()
-		if err != nil {
-			return nil, fmt.Errorf("failed to get server time: %w", err)
-		}
-		timestamp = &serverTime
-	}
-
-	l1Headers, err := headers.CreateL1Headers(c.signer, option, c.chainID, nonce, timestamp)
+	timestamp := c.headerTime(ctx)
+	l1Headers, err := headers.CreateL1Headers(c.signer, option, c.chainID, nonce, &timestamp)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create L1 headers: %w", err)
 	}
 
 	var apiKeyRaw types.ApiKeyRaw
-	err = c.getJSONWithHeaders(endpoint.DeriveApiKey, l1Headers, &apiKeyRaw)
+	err = c.getJSONWithHeaders(ctx, endpoint.DeriveApiKey, l1Headers, &apiKeyRaw)
 	if err != nil {
 		return nil, err
 	}
@@ -332,7 +505,11 @@ func (c *ClobClient) DeriveApiKey(nonce *uint64, option clob_types.ClobOption) (
 
 // GetApiKeys gets API keys
 func (c *ClobClient) GetApiKeys(addr common.Address) (*types.ApiKeysResponse, error) {
-	if c.creds == nil {
+	return c.GetApiKeysWithContext(context.Background(), addr)
+}
+
+func (c *ClobClient) GetApiKeysWithContext(ctx context.Context, addr common.Address) (*types.ApiKeysResponse, error) {
+	if c.apiCreds() == nil {
 		return nil, fmt.Errorf("API credentials are required")
 	}
 
@@ -341,19 +518,23 @@ func (c *ClobClient) GetApiKeys(addr common.Address) (*types.ApiKeysResponse, er
 		RequestPath: endpoint.GetApiKeys,
 	}
 
-	l2Headers, err := c.createL2Headers(addr, headerArgs)
+	l2Headers, err := c.createL2Headers(ctx, addr, headerArgs)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create L2 headers: %w", err)
 	}
 
 	var result types.ApiKeysResponse
-	err = c.getJSONWithHeaders(endpoint.GetApiKeys, l2Headers, &result)
+	err = c.getJSONWithHeaders(ctx, endpoint.GetApiKeys, l2Headers, &result)
 	return &result, err
 }
 
 // GetClosedOnlyMode gets closed only mode status
 func (c *ClobClient) GetClosedOnlyMode(funder common.Address) (*types.BanStatus, error) {
-	if c.creds == nil {
+	return c.GetClosedOnlyModeWithContext(context.Background(), funder)
+}
+
+func (c *ClobClient) GetClosedOnlyModeWithContext(ctx context.Context, funder common.Address) (*types.BanStatus, error) {
+	if c.apiCreds() == nil {
 		return nil, fmt.Errorf("API credentials are required")
 	}
 
@@ -362,19 +543,23 @@ func (c *ClobClient) GetClosedOnlyMode(funder common.Address) (*types.BanStatus,
 		RequestPath: endpoint.ClosedOnly,
 	}
 
-	l2Headers, err := c.createL2Headers(funder, headerArgs)
+	l2Headers, err := c.createL2Headers(ctx, funder, headerArgs)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create L2 headers: %w", err)
 	}
 
 	var result types.BanStatus
-	err = c.getJSONWithHeaders(endpoint.ClosedOnly, l2Headers, &result)
+	err = c.getJSONWithHeaders(ctx, endpoint.ClosedOnly, l2Headers, &result)
 	return &result, err
 }
 
 // DeleteApiKey deletes API key
 func (c *ClobClient) DeleteApiKey(funder common.Address) error {
-	if c.creds == nil {
+	return c.DeleteApiKeyWithContext(context.Background(), funder)
+}
+
+func (c *ClobClient) DeleteApiKeyWithContext(ctx context.Context, funder common.Address) error {
+	if c.apiCreds() == nil {
 		return fmt.Errorf("API credentials are required")
 	}
 
@@ -383,17 +568,21 @@ func (c *ClobClient) DeleteApiKey(funder common.Address) error {
 		RequestPath: endpoint.DeleteApiKey,
 	}
 
-	l2Headers, err := c.createL2Headers(funder, headerArgs)
+	l2Headers, err := c.createL2Headers(ctx, funder, headerArgs)
 	if err != nil {
 		return fmt.Errorf("failed to create L2 headers: %w", err)
 	}
 
-	return c.deleteWithHeaders(endpoint.DeleteApiKey, l2Headers, nil, nil)
+	return c.deleteWithHeaders(ctx, endpoint.DeleteApiKey, l2Headers, nil, nil)
 }
 
 // GetOrder gets an order by ID
 func (c *ClobClient) GetOrder(funder common.Address, orderID string) (*types.OpenOrder, error) {
-	if c.creds == nil {
+	return c.GetOrderWithContext(context.Background(), funder, orderID)
+}
+
+func (c *ClobClient) GetOrderWithContext(ctx context.Context, funder common.Address, orderID string) (*types.OpenOrder, error) {
+	if c.apiCreds() == nil {
 		return nil, fmt.Errorf("API credentials are required")
 	}
 
@@ -403,20 +592,40 @@ func (c *ClobClient) GetOrder(funder common.Address, orderID string) (*types.Ope
 		RequestPath: endpoint,
 	}
 
-	headers, err := c.createL2Headers(funder, headerArgs)
+	headers, err := c.createL2Headers(ctx, funder, headerArgs)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create L2 headers: %w", err)
 	}
 
 	var result types.OpenOrder
-	err = c.getJSONWithHeaders(endpoint, headers, &result)
+	err = c.getJSONWithHeaders(ctx, endpoint, headers, &result)
 	return &result, err
 }
 
 // GetTrades gets trades
 func (c *ClobClient) GetTrades(funder common.Address, params *types.TradeParams, onlyFirstPage bool, nextCursor string) ([]types.Trade, error) {
-	if c.creds == nil {
-		return nil, fmt.Errorf("API credentials are required")
+	return c.GetTradesWithContext(context.Background(), funder, params, onlyFirstPage, nextCursor)
+}
+
+func (c *ClobClient) GetTradesWithContext(ctx context.Context, funder common.Address, params *types.TradeParams, onlyFirstPage bool, nextCursor string) ([]types.Trade, error) {
+	trades, cursor, err := c.getTradesPage(ctx, funder, params, nextCursor)
+	if err != nil {
+		return nil, err
+	}
+	for !onlyFirstPage && !isLastCursor(cursor) {
+		var page []types.Trade
+		page, cursor, err = c.getTradesPage(ctx, funder, params, cursor)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get trades page: %w", err)
+		}
+		trades = append(trades, page...)
+	}
+	return trades, nil
+}
+
+func (c *ClobClient) getTradesPage(ctx context.Context, funder common.Address, params *types.TradeParams, nextCursor string) ([]types.Trade, string, error) {
+	if c.apiCreds() == nil {
+		return nil, "", fmt.Errorf("API credentials are required")
 	}
 
 	headerArgs := &types.L2HeaderArgs{
@@ -424,9 +633,9 @@ func (c *ClobClient) GetTrades(funder common.Address, params *types.TradeParams,
 		RequestPath: endpoint.GetTrades,
 	}
 
-	headers, err := c.createL2Headers(funder, headerArgs)
+	headers, err := c.createL2Headers(ctx, funder, headerArgs)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create L2 headers: %w", err)
+		return nil, "", fmt.Errorf("failed to create L2 headers: %w", err)
 	}
 
 	queryParams := url.Values{}
@@ -461,265 +670,255 @@ func (c *ClobClient) GetTrades(funder common.Address, params *types.TradeParams,
 		NextCursor string        `json:"next_cursor"`
 	}
 
-	err = c.getJSONWithHeadersAndParams(endpoint.GetTrades, headers, queryParams, &result)
+	err = c.getJSONWithHeadersAndParams(ctx, endpoint.GetTrades, headers, queryParams, &result)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-
-	if onlyFirstPage || result.NextCursor == "-1" {
-		return result.Data, nil
-	}
-
-	// Recursively get all pages
-	moreTrades, err := c.GetTrades(funder, params, onlyFirstPage, result.NextCursor)
-	if err != nil {
-		return result.Data, nil // Return what we have so far
-	}
-
-	return append(result.Data, moreTrades...), nil
+	return result.Data, result.NextCursor, nil
 }
 
 // Helper methods for HTTP requests
 
-func (c *ClobClient) get(endpoint string) (interface{}, error) {
-	return c.getWithParams(endpoint, url.Values{})
+func (c *ClobClient) get(ctx context.Context, endpoint string) (interface{}, error) {
+	return c.getWithParams(ctx, endpoint, url.Values{})
 }
 
-func (c *ClobClient) getWithParams(endpoint string, params url.Values) ([]byte, error) {
-	fullURL := c.host + endpoint
-	if len(params) > 0 {
-		fullURL += "?" + params.Encode()
-	}
-	// log.Printf("GET full %s\n", fullURL)
-	req, err := http.NewRequest("GET", fullURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	// Add geo block token if present
-	if c.geoBlockToken != "" {
-		q := req.URL.Query()
-		q.Add("geo_block_token", c.geoBlockToken)
-		req.URL.RawQuery = q.Encode()
-	}
+func (c *ClobClient) getWithParams(ctx context.Context, endpoint string, params url.Values) ([]byte, error) {
+	return c.doRequest(ctx, "GET", endpoint, nil, params, nil)
+}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %w", err)
-	}
-	defer resp.Body.Close()
+func (c *ClobClient) getJSON(ctx context.Context, endpoint string, result interface{}) error {
+	return c.getJSONWithParams(ctx, endpoint, url.Values{}, result)
+}
 
-	if resp.StatusCode >= 400 {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(body))
-	}
-	resBytes, err := io.ReadAll(resp.Body)
+func (c *ClobClient) getJSONWithParams(ctx context.Context, endpoint string, params url.Values, result interface{}) error {
+	data, err := c.getWithParams(ctx, endpoint, params)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return err
 	}
-	return resBytes, nil
+	//log.Printf("data: %s", string(data))
+	return sonic.Unmarshal(data, result)
 }
 
-func (c *ClobClient) getJSON(endpoint string, result interface{}) error {
-	return c.getJSONWithParams(endpoint, url.Values{}, result)
+func (c *ClobClient) getJSONWithHeaders(ctx context.Context, endpoint string, headers interface{}, result interface{}) error {
+	return c.getJSONWithHeadersAndParams(ctx, endpoint, headers, url.Values{}, result)
 }
 
-func (c *ClobClient) getJSONWithParams(endpoint string, params url.Values, result interface{}) error {
-	data, err := c.getWithParams(endpoint, params)
+func (c *ClobClient) getJSONWithHeadersAndParams(ctx context.Context, endpoint string, headers interface{}, params url.Values, result interface{}) error {
+	body, err := c.doRequest(ctx, "GET", endpoint, headers, params, nil)
 	if err != nil {
 		return err
 	}
-	//log.Printf("data: %s", string(data))
-	return sonic.Unmarshal(data, result)
+	if result == nil {
+		return nil
+	}
+	return sonic.Unmarshal(body, result)
 }
 
-func (c *ClobClient) getJSONWithHeaders(endpoint string, headers interface{}, result interface{}) error {
-	return c.getJSONWithHeadersAndParams(endpoint, headers, url.Values{}, result)
+func (c *ClobClient) postJSON(ctx context.Context, endpoint string, data interface{}, result interface{}) error {
+	return c.postJSONWithHeaders(ctx, endpoint, nil, data, result)
 }
 
-func (c *ClobClient) getJSONWithHeadersAndParams(endpoint string, headers interface{}, params url.Values, result interface{}) error {
-	fullURL := c.host + endpoint
-	if len(params) > 0 {
-		fullURL += "?" + params.Encode()
-	}
+// ErrResp is the error body of the CLOB API.
+//
+// Deprecated: errors are returned as *types.APIError.
+type ErrResp struct {
+	Error string `json:"error"`
+}
 
-	req, err := http.NewRequest("GET", fullURL, nil)
+func (c *ClobClient) postJSONWithHeaders(ctx context.Context, endpoint string, headers interface{}, data interface{}, result interface{}) error {
+	reqBody, err := requestBody(data)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return fmt.Errorf("failed to marshal request data: %w", err)
+	}
+	body, err := c.doRequest(ctx, "POST", endpoint, headers, nil, reqBody)
+	if err != nil {
+		return err
 	}
 
-	// Add headers
-	c.addHeadersToRequest(req, headers)
-
-	// Add geo block token if present
-	if c.geoBlockToken != "" {
-		q := req.URL.Query()
-		q.Add("geo_block_token", c.geoBlockToken)
-		req.URL.RawQuery = q.Encode()
+	if result != nil {
+		err = sonic.Unmarshal(body, result)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal response: %w, body: %s", err, string(body))
+		}
+		return nil
 	}
+	return errors.New("result should not be nil")
+}
 
-	resp, err := c.httpClient.Do(req)
+func (c *ClobClient) deleteWithHeaders(ctx context.Context, endpoint string, headers interface{}, data interface{}, result interface{}) error {
+	reqBody, err := requestBody(data)
 	if err != nil {
-		return fmt.Errorf("failed to make request: %w", err)
+		return fmt.Errorf("failed to marshal delete body: %w", err)
 	}
-	defer resp.Body.Close()
-	body, err := io.ReadAll(resp.Body)
+	body, err := c.doRequest(ctx, "DELETE", endpoint, headers, nil, reqBody)
 	if err != nil {
-		return fmt.Errorf("failed to read response body: %w", err)
-	}
-	//log.Printf("getJSONWithHeadersAndParams body: %s\n", string(body))
-	if resp.StatusCode >= 400 {
-		return fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(body))
+		return err
 	}
 
-	return sonic.Unmarshal(body, result)
+	if result != nil {
+		if err := sonic.Unmarshal(body, result); err != nil {
+			return fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+	}
+	return nil
 }
 
-func (c *ClobClient) postJSON(endpoint string, data interface{}, result interface{}) error {
-	return c.postJSONWithHeaders(endpoint, nil, data, result)
+// requestBody returns the JSON body of data, passing strings and bytes through as is.
+func requestBody(data interface{}) ([]byte, error) {
+	switch v := data.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []byte(v), nil
+	case []byte:
+		return v, nil
+	case json.RawMessage:
+		return v, nil
+	default:
+		return sonic.Marshal(v)
+	}
 }
 
-type ErrResp struct {
-	Error string `json:"error"`
+// readOnlyPosts are the POST endpoints that only read market data and are safe to retry.
+var readOnlyPosts = map[string]bool{
+	endpoint.GetOrderBooks:       true,
+	endpoint.GetPrices:           true,
+	endpoint.GetMidpoints:        true,
+	endpoint.GetSpreads:          true,
+	endpoint.GetLastTradesPrices: true,
 }
 
-func (c *ClobClient) postJSONWithHeaders(endpoint string, headers interface{}, data interface{}, result interface{}) error {
-	var bodyReader io.Reader
-	if data != nil {
-		switch v := data.(type) {
-		case string:
-			bodyReader = strings.NewReader(v)
-		case []byte:
-			bodyReader = bytes.NewReader(v)
-		case json.RawMessage:
-			bodyReader = bytes.NewReader(v)
-		default:
-			jsonData, err := sonic.Marshal(v)
-			if err != nil {
-				return fmt.Errorf("failed to marshal request data: %w", err)
-			}
-			bodyReader = bytes.NewReader(jsonData)
-		}
-	}
-
-	req, err := http.NewRequest("POST", c.host+endpoint, bodyReader)
+// doRequest sends one request to the CLOB and returns the response body. Every attempt
+// waits for the rate limiter. GET, DELETE and read-only POST requests are retried
+// according to the retry policy; other POST requests only when it opts in.
+func (c *ClobClient) doRequest(ctx context.Context, method, endpoint string, headers interface{}, params url.Values, body []byte) ([]byte, error) {
+	u, err := url.Parse(c.host + endpoint)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return nil, fmt.Errorf("failed to parse request url: %w", err)
 	}
-
-	if data != nil {
-		req.Header.Set("Content-Type", "application/json")
+	query := u.Query()
+	for k, v := range params {
+		query[k] = append(query[k], v...)
 	}
-
-	// Add headers
-	c.addHeadersToRequest(req, headers)
-
 	// Add geo block token if present
 	if c.geoBlockToken != "" {
-		q := req.URL.Query()
-		q.Add("geo_block_token", c.geoBlockToken)
-		req.URL.RawQuery = q.Encode()
+		query.Add("geo_block_token", c.geoBlockToken)
 	}
+	u.RawQuery = query.Encode()
+	fullURL := u.String()
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to make request: %w", err)
-	}
-	defer resp.Body.Close()
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return fmt.Errorf("failed to read response body: %w", err)
-	}
-	if resp.StatusCode >= 400 {
-		respErr := &ErrResp{}
-		err = sonic.Unmarshal(body, respErr)
+	path, _, _ := strings.Cut(endpoint, "?")
+	idempotent := method != "POST" || readOnlyPosts[path]
+	var resBytes []byte
+	err = c.retryPolicy.Do(ctx, idempotent, func() error {
+		if err := c.rateLimiter.wait(ctx, method, endpoint); err != nil {
+			return err
+		}
+		var bodyReader io.Reader
+		if body != nil {
+			bodyReader = bytes.NewReader(body)
+		}
+		req, err := http.NewRequestWithContext(ctx, method, fullURL, bodyReader)
 		if err != nil {
-			return fmt.Errorf("failed to unmarshal response of code 400: %w, body:%s\n", err, string(body))
+			return fmt.Errorf("failed to create request: %w", err)
 		}
-		return fmt.Errorf("%s", respErr.Error)
-	}
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		c.addHeadersToRequest(req, headers)
 
-	if result != nil {
-		err = sonic.Unmarshal(body, result)
+		start := time.Now()
+		resp, err := c.httpClient.Do(req)
 		if err != nil {
-			return fmt.Errorf("failed to unmarshal response: %w, body: %s", err, string(body))
+			c.logger.Debug("clob request failed", "method", method, "endpoint", endpoint, "error", err)
+			return fmt.Errorf("failed to make request: %w", err)
+		}
+		defer resp.Body.Close()
+		c.logger.Debug("clob request", "method", method, "endpoint", endpoint, "status", resp.StatusCode, "duration", time.Since(start))
+		resBytes, err = io.ReadAll(resp.Body)
+		if err != nil {
+			return fmt.Errorf("failed to read response body: %w", err)
+		}
+		if resp.StatusCode >= 400 {
+			return types.NewAPIError(resp, resBytes)
 		}
 		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	return errors.New("result should not be nil")
+	return resBytes, nil
 }
 
-func (c *ClobClient) deleteWithHeaders(endpoint string, headers interface{}, data interface{}, result interface{}) error {
-	var bodyReader io.Reader
-	if data != nil {
-		switch v := data.(type) {
-		case string:
-			bodyReader = strings.NewReader(v)
-		case []byte:
-			bodyReader = bytes.NewReader(v)
-		case json.RawMessage:
-			bodyReader = bytes.NewReader(v)
-		default:
-			j, err := sonic.Marshal(v)
-			if err != nil {
-				return fmt.Errorf("failed to marshal delete body: %w", err)
-			}
-			bodyReader = bytes.NewReader(j)
-		}
+func (c *ClobClient) createL2Headers(ctx context.Context, addr common.Address, args *types.L2HeaderArgs) (interface{}, error) {
+	if c.signer == nil {
+		return nil, fmt.Errorf("signer is required for authenticated requests")
 	}
 
-	req, err := http.NewRequest("DELETE", c.host+endpoint, bodyReader)
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+	return headers.CreateL2Headers(addr, c.apiCreds(), args, c.headerTimestamp(ctx))
+}
+
+// headerTime is the unix timestamp signed into request headers: the synced server
+// clock when UseServerTime is set, the local clock otherwise.
+func (c *ClobClient) headerTime(ctx context.Context) int64 {
+	if c.clock != nil {
+		return c.clock.Now(ctx).Unix()
 	}
-	if data != nil {
-		req.Header.Set("Content-Type", "application/json")
+	return time.Now().Unix()
+}
+
+// validationNow is the time orders are validated against. It applies the last synced
+// server offset without syncing, so that validating an order never sends a request.
+func (c *ClobClient) validationNow() time.Time {
+	if c.clock != nil {
+		return time.Now().Add(c.clock.Offset())
 	}
+	return time.Now()
+}
 
-	c.addHeadersToRequest(req, headers)
+func (c *ClobClient) headerTimestamp(ctx context.Context) string {
+	return strconv.FormatInt(c.headerTime(ctx), 10)
+}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to make request: %w", err)
+// tradingTimestamp is the timestamp signed into the headers of the order and cancel
+// endpoints. With UseServerTime it is the synced server clock; otherwise the server time
+// is fetched for each request, so that trading never depends on the local clock.
+func (c *ClobClient) tradingTimestamp(ctx context.Context) (string, error) {
+	if c.clock != nil {
+		return c.headerTimestamp(ctx), nil
 	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
+	serverTime, err := c.GetServerTimeWithContext(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to read response body: %w", err)
-	}
-
-	if resp.StatusCode >= 400 {
-		return fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(body))
+		return "", fmt.Errorf("failed to get server time: %w", err)
 	}
+	return strconv.FormatInt(serverTime, 10), nil
+}
 
-	if result != nil {
-		if err := sonic.Unmarshal(body, result); err != nil {
-			return fmt.Errorf("failed to unmarshal response: %w", err)
-		}
+// SyncClock measures the server clock offset now. It is only needed to surface
+// sync errors: with UseServerTime the offset is measured on first use and then
+// every ClockSyncInterval.
+func (c *ClobClient) SyncClock(ctx context.Context) error {
+	if c.clock == nil {
+		return fmt.Errorf("server time is not enabled")
 	}
-	return nil
+	return c.clock.Sync(ctx)
 }
 
-func (c *ClobClient) createL2Headers(addr common.Address, args *types.L2HeaderArgs) (interface{}, error) {
-	if c.signer == nil {
-		return nil, fmt.Errorf("signer is required for authenticated requests")
+// StartClockSync keeps the server clock offset measured in the background until ctx is done,
+// so that no signature waits for a sync.
+func (c *ClobClient) StartClockSync(ctx context.Context) {
+	if c.clock != nil {
+		c.clock.Start(ctx)
 	}
+}
 
-	var timestamp *int64
-	var tsStr string
-	if c.useServerTime {
-		serverTime, err := c.GetServerTime()
-		if err != nil {
-			return nil, fmt.Errorf("failed to get server time: %w", err)
-		}
-		timestamp = &serverTime
-		tsStr = strconv.FormatInt(*timestamp, 10)
-	} else {
-		tsStr = strconv.FormatInt(time.Now().Unix(), 10)
+// ClockOffset is the measured server clock minus the local clock.
+func (c *ClobClient) ClockOffset() time.Duration {
+	if c.clock == nil {
+		return 0
 	}
-
-	return headers.CreateL2Headers(addr, c.creds, args, tsStr)
+	return c.clock.Offset()
 }
 
 func (c *ClobClient) addHeadersToRequest(req *http.Request, requestHeaders interface{}) {
@@ -736,11 +935,14 @@ func (c *ClobClient) addHeadersToRequest(req *http.Request, requestHeaders inter
 		req.Header.Set("POLY_API_KEY", h.POLYAPIKey)
 		req.Header.Set("POLY_PASSPHRASE", h.POLYPassphrase)
 	case *headers.L2WithBuilderHeader:
-		req.Header.Set("POLY_ADDRESS", h.POLYAddress)
-		req.Header.Set("POLY_SIGNATURE", h.POLYSignature)
-		req.Header.Set("POLY_TIMESTAMP", h.POLYTimestamp)
-		req.Header.Set("POLY_API_KEY", h.POLYAPIKey)
-		req.Header.Set("POLY_PASSPHRASE", h.POLYPassphrase)
+		// builder-only requests carry no L2 headers
+		if h.POLYAPIKey != "" {
+			req.Header.Set("POLY_ADDRESS", h.POLYAddress)
+			req.Header.Set("POLY_SIGNATURE", h.POLYSignature)
+			req.Header.Set("POLY_TIMESTAMP", h.POLYTimestamp)
+			req.Header.Set("POLY_API_KEY", h.POLYAPIKey)
+			req.Header.Set("POLY_PASSPHRASE", h.POLYPassphrase)
+		}
 		req.Header.Set("POLY_BUILDER_API_KEY", h.POLYBuilderAPIKey)
 		req.Header.Set("POLY_BUILDER_TIMESTAMP", h.POLYBuilderTimestamp)
 		req.Header.Set("POLY_BUILDER_PASSPHRASE", h.POLYBuilderPassphrase)
@@ -749,7 +951,7 @@ func (c *ClobClient) addHeadersToRequest(req *http.Request, requestHeaders inter
 }
 
 func (c *ClobClient) GetClientMode() constants.AccessLevel {
-	if c.signer != nil && c.creds != nil {
+	if c.signer != nil && c.apiCreds() != nil {
 		return constants.L2
 	}
 	if c.signer != nil {
@@ -780,68 +982,118 @@ func (c *ClobClient) canBuilderAuth() bool {
 }
 
 func (c *ClobClient) CreateAndPostOrder(args clob_types.OrderArgs, option clob_types.PartialCreateOrderOptions) (*types.OrderResponse, error) {
-	if c.signer.SignerType() == signer.Turnkey {
-		if option.TurnkeyAccount == constants.ZERO_ADDRESS {
-			return nil, fmt.Errorf("turnkeyAccount is required")
-		}
-		if option.SafeAccount == constants.ZERO_ADDRESS {
-			return nil, fmt.Errorf("safe account is required")
-		}
+	return c.CreateAndPostOrderWithContext(context.Background(), args, option)
+}
+
+func (c *ClobClient) CreateAndPostOrderWithContext(ctx context.Context, args clob_types.OrderArgs, option clob_types.PartialCreateOrderOptions) (*types.OrderResponse, error) {
+	if err := c.assertTradingAccounts(option); err != nil {
+		return nil, err
 	}
-	signedOrder, err := c.createOrder(args, option)
+	signedOrder, err := c.createOrder(ctx, args, option)
 	if err != nil {
 		return nil, err
 	}
-	return c.postOrder(signedOrder, option)
+	return c.postOrder(ctx, signedOrder, option)
+}
+
+// assertTradingAccounts checks that a Turnkey signer is given the accounts it trades with.
+func (c *ClobClient) assertTradingAccounts(option clob_types.PartialCreateOrderOptions) error {
+	if c.signer.SignerType() != signer.Turnkey {
+		return nil
+	}
+	if option.TurnkeyAccount == constants.ZERO_ADDRESS {
+		return fmt.Errorf("turnkeyAccount is required")
+	}
+	if option.SafeAccount == constants.ZERO_ADDRESS {
+		return fmt.Errorf("safe account is required")
+	}
+	return nil
 }
 
-func (c *ClobClient) createOrder(args clob_types.OrderArgs, option clob_types.PartialCreateOrderOptions) (utils_order_builder.SignedOrder, error) {
+func (c *ClobClient) createOrder(ctx context.Context, args clob_types.OrderArgs, option clob_types.PartialCreateOrderOptions) (utils_order_builder.SignedOrder, error) {
 	err := c.AssertL1Auth()
 	if err != nil {
 		return utils_order_builder.SignedOrder{}, err
 	}
 	if option.TickSize == nil {
-		tickSize, err := c.GetTickSize(args.TokenID)
+		tickSize, err := c.GetTickSizeWithContext(ctx, args.TokenID)
 		if err != nil {
 			return utils_order_builder.SignedOrder{}, err
 		}
 		option.TickSize = &tickSize
 	}
 
-	err = c.priceValid(args.Price, *option.TickSize)
-	if err != nil {
+	vctx := c.preflightContext(args.TokenID, *option.TickSize)
+	if err := preflightError(ValidateOrder(args, option, vctx)); err != nil {
 		return utils_order_builder.SignedOrder{}, err
 	}
 	if option.NegRisk == nil {
-		isNegRisk, err := c.GetNegRisk(args.TokenID)
+		isNegRisk, err := c.GetNegRiskWithContext(ctx, args.TokenID)
 		if err != nil {
 			return utils_order_builder.SignedOrder{}, err
 		}
 		option.NegRisk = &isNegRisk
 	}
 
-	feeRateBps, err := c.ResolveFeeRateBps(args.TokenID, args.FeeRateBps)
+	feeRateBps, err := c.ResolveFeeRateBpsWithContext(ctx, args.TokenID, args.FeeRateBps)
 	if err != nil {
 		return utils_order_builder.SignedOrder{}, err
 	}
 	args.FeeRateBps = feeRateBps
+
+	orderBuilder, err := c.newOrderBuilder(option)
+	if err != nil {
+		return utils_order_builder.SignedOrder{}, err
+	}
+	// signing may be a remote Turnkey call, don't start it for an abandoned request
+	if err := ctx.Err(); err != nil {
+		return utils_order_builder.SignedOrder{}, err
+	}
+	order, err := orderBuilder.CreateOrder(c.signer, args, option)
+	if err != nil {
+		return utils_order_builder.SignedOrder{}, err
+	}
+	if err := c.verifySignedOrder(order, option, args.Price); err != nil {
+		return utils_order_builder.SignedOrder{}, err
+	}
+	return order, nil
+}
+
+// verifySignedOrder runs utils_order_builder.VerifySignedOrder on an order the client
+// just signed when VerifySignedOrders is configured.
+func (c *ClobClient) verifySignedOrder(order utils_order_builder.SignedOrder, option clob_types.PartialCreateOrderOptions, price decimal.Decimal) error {
+	if !c.verifyOrders {
+		return nil
+	}
+	err := utils_order_builder.VerifySignedOrder(order, utils_order_builder.VerifyOptions{
+		ChainID:  types.Chain(c.signer.ChainID()),
+		NegRisk:  *option.NegRisk,
+		Price:    price,
+		TickSize: *option.TickSize,
+	})
+	if err != nil {
+		return fmt.Errorf("signed order failed verification: %w", err)
+	}
+	return nil
+}
+
+// newOrderBuilder picks the funder and signature type for the configured signer:
+// Turnkey accounts trade through their Gnosis Safe, private keys trade as EOA.
+func (c *ClobClient) newOrderBuilder(option clob_types.PartialCreateOrderOptions) (*order_builder.OrderBuilder, error) {
 	var funder common.Address
-	var signatureType constants.SigType
 	if c.signer.SignerType() == signer.Turnkey {
-		signatureType = constants.POLY_GNOSIS_SAFE
 		funder = option.SafeAccount
-
 	} else if c.signer.SignerType() == signer.PrivateKey {
-		signatureType = constants.EOA
 		funder = common.HexToAddress(c.signer.Address())
 	}
+	return order_builder.NewOrderBuilder(c.signer, c.signatureType(), funder)
+}
 
-	orderBuilder, err := order_builder.NewOrderBuilder(c.signer, signatureType, funder)
-	if err != nil {
-		return utils_order_builder.SignedOrder{}, err
+func (c *ClobClient) signatureType() constants.SigType {
+	if c.signer != nil && c.signer.SignerType() == signer.Turnkey {
+		return constants.POLY_GNOSIS_SAFE
 	}
-	return orderBuilder.CreateOrder(c.signer, args, option)
-
+	return constants.EOA
 }
 
 type RequestArgs struct {
@@ -851,21 +1103,26 @@ type RequestArgs struct {
 }
 
 // postOrder:
-func (c *ClobClient) postOrder(order utils_order_builder.SignedOrder, option clob_types.PartialCreateOrderOptions) (*types.OrderResponse, error) {
+func (c *ClobClient) postOrder(ctx context.Context, order utils_order_builder.SignedOrder, option clob_types.PartialCreateOrderOptions) (*types.OrderResponse, error) {
 	err := c.AssertL2Auth()
 	if err != nil {
 		return nil, err
 	}
-	if c.creds == nil {
+	if c.apiCreds() == nil {
 		return nil, fmt.Errorf("API credentials required")
 	}
 	if option.OrderType == "" {
 		option.OrderType = types.OrderTypeGTC
 	}
-	body, err := c.orderToBody(order, c.creds, string(option.OrderType))
+	body, err := c.orderToBody(order, c.apiCreds(), option)
 	if err != nil {
 		return nil, err
 	}
+	return c.postOrderBody(ctx, body, option)
+}
+
+// postOrderBody posts an order body to /order with L2 (and builder) headers.
+func (c *ClobClient) postOrderBody(ctx context.Context, body *FinalBody, option clob_types.PartialCreateOrderOptions) (*types.OrderResponse, error) {
 	bodyStr, err := sonic.MarshalString(body)
 	if err != nil {
 		return nil, err
@@ -882,16 +1139,31 @@ func (c *ClobClient) postOrder(order utils_order_builder.SignedOrder, option clo
 		Body:           bodyStr,
 		SerializedBody: serializedBody,
 	}
-	timestamp, err := c.GetServerTime()
+	reqHeaders, err := c.tradingHeaders(ctx, requestArgs, option)
+	if err != nil {
+		return nil, err
+	}
+	result := types.OrderResponse{}
+	err = c.postJSONWithHeaders(ctx, endpoint.PostOrder, reqHeaders, serializedBody, &result)
 	if err != nil {
+		c.logger.Warn("post order failed", "error", err)
 		return nil, err
 	}
-	tsStr := strconv.FormatInt(timestamp, 10)
+	return &result, nil
+}
 
-	l2headers := &types.L2PolyHeader{}
-	if c.signer.SignerType() == signer.Turnkey {
+// tradingHeaders signs L2 headers for an order endpoint as the trading account
+// (Turnkey account or private key address) and attaches builder headers when
+// a valid builder config is present.
+func (c *ClobClient) tradingHeaders(ctx context.Context, requestArgs *types.L2HeaderArgs, option clob_types.PartialCreateOrderOptions) (interface{}, error) {
+	tsStr, err := c.tradingTimestamp(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-		l2headers, err = headers.CreateL2Headers(option.TurnkeyAccount, c.creds, requestArgs, tsStr)
+	var l2headers *types.L2PolyHeader
+	if c.signer.SignerType() == signer.Turnkey {
+		l2headers, err = headers.CreateL2Headers(option.TurnkeyAccount, c.apiCreds(), requestArgs, tsStr)
 		if err != nil {
 			return nil, err
 		}
@@ -900,43 +1172,23 @@ func (c *ClobClient) postOrder(order utils_order_builder.SignedOrder, option clo
 		if err != nil {
 			return nil, err
 		}
-		l2headers, err = headers.CreateL2Headers(pub, c.creds, requestArgs, strconv.FormatInt(timestamp, 10))
+		l2headers, err = headers.CreateL2Headers(pub, c.apiCreds(), requestArgs, tsStr)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	canBuilderAuth := c.canBuilderAuth()
-	if canBuilderAuth {
-		builderHeaders, err := c.builderConfig.GenerateBuilderHeaders(requestArgs.Method, requestArgs.RequestPath, &requestArgs.SerializedBody, tsStr)
-		if err != nil {
-			return nil, err
-		}
-		if builderHeaders == nil {
-			return nil, fmt.Errorf("builder headers is nil")
-		}
-		enriched := headers.InsertBuilderHeaders(l2headers, builderHeaders)
-		result := types.OrderResponse{}
-		err = c.postJSONWithHeaders(endpoint.PostOrder, enriched, serializedBody, &result)
-		if err != nil {
-			log.Printf("postJSONWithHeaders: %s\n", err)
-			return nil, err
-		}
-		return &result, nil
-	} else {
-		result := types.OrderResponse{}
-		l2h, err := sonic.MarshalString(l2headers)
-		if err != nil {
-			return nil, err
-		}
-		log.Printf("before post l2headers:%v\n", l2h)
-		err = c.postJSONWithHeaders(endpoint.PostOrder, l2headers, serializedBody, &result)
-		if err != nil {
-			return nil, err
-		}
-		return &result, nil
+	if !c.canBuilderAuth() {
+		return l2headers, nil
 	}
-
+	builderHeaders, err := c.builderConfig.GenerateBuilderHeaders(requestArgs.Method, requestArgs.RequestPath, &requestArgs.SerializedBody, tsStr)
+	if err != nil {
+		return nil, err
+	}
+	if builderHeaders == nil {
+		return nil, fmt.Errorf("builder headers is nil")
+	}
+	return headers.InsertBuilderHeaders(l2headers, builderHeaders), nil
 }
 
 func serializeJsonBody(v any) (string, error) {
@@ -956,15 +1208,36 @@ type FinalBody struct {
 	Order     types.SignedOrder `json:"order"`
 	Owner     string            `json:"owner"`
 	OrderType string            `json:"orderType"`
+	PostOnly  bool              `json:"postOnly,omitempty"`
+}
+
+func (c *ClobClient) orderToBody(order utils_order_builder.SignedOrder, creds *types.ApiKeyCreds, option clob_types.PartialCreateOrderOptions) (*FinalBody, error) {
+	return newFinalBody(wireOrder(order), creds, option.OrderType, option.PostOnly)
 }
 
-func (c *ClobClient) orderToBody(order utils_order_builder.SignedOrder, creds *types.ApiKeyCreds, orderType string) (*FinalBody, error) {
+func newFinalBody(order types.SignedOrder, creds *types.ApiKeyCreds, orderType types.OrderType, postOnly bool) (*FinalBody, error) {
 	if creds.Key == "" {
 		return nil, fmt.Errorf("API credentials required")
 	}
-	sigType := int(order.SignatureType)
+	var vs violations
+	if postOnly {
+		vs.checkPostOnly(orderType)
+	}
+	if err := violationsError(vs); err != nil {
+		return nil, err
+	}
+	body := &FinalBody{
+		Order:     order,
+		Owner:     creds.Key,
+		OrderType: string(orderType),
+		PostOnly:  postOnly,
+	}
+	return body, nil
+}
 
-	fOrder := types.SignedOrder{
+// wireOrder converts a signed order to the representation the exchange accepts.
+func wireOrder(order utils_order_builder.SignedOrder) types.SignedOrder {
+	return types.SignedOrder{
 		Salt:          order.Salt,
 		Maker:         order.Maker.Hex(),
 		Signer:        order.Signer.Hex(),
@@ -976,186 +1249,244 @@ func (c *ClobClient) orderToBody(order utils_order_builder.SignedOrder, creds *t
 		Nonce:         order.Nonce,
 		FeeRateBps:    order.FeeRateBps,
 		Side:          order.Side,
-		SignatureType: types.SignatureType(sigType),
+		SignatureType: types.SignatureType(order.SignatureType),
 		Signature:     order.Signature,
 	}
-	body := &FinalBody{
-		Order:     fOrder,
-		Owner:     creds.Key,
-		OrderType: orderType,
-	}
-	return body, nil
 }
 
-func (c *ClobClient) generateBuilderHeaders(headerArgs types.L2HeaderArgs, headers types.L2PolyHeader) {
-
+func (c *ClobClient) CancelOrder(orderId string, signerAddr common.Address) (*types.OrderResponse, error) {
+	return c.CancelOrderWithContext(context.Background(), orderId, signerAddr)
 }
-func (c *ClobClient) priceValid(price decimal.Decimal, tickSize types.TickSize) error {
-	ts, err := decimal.NewFromString(string(tickSize))
-	if err != nil {
-		return err
-	}
 
-	minPara := ts
-	maxPara := decimal.NewFromFloat(1).Sub(ts)
-
-	if price.LessThan(minPara) || price.GreaterThan(maxPara) {
-		return fmt.Errorf(
-			"price (%v), min: %v - max: %v",
-			price,
-			minPara,
-			maxPara,
-		)
+func (c *ClobClient) CancelOrderWithContext(ctx context.Context, orderId string, signerAddr common.Address) (*types.OrderResponse, error) {
+	body := map[string]string{"orderId": orderId}
+	var result types.OrderResponse
+	if err := c.cancelWithL2(ctx, endpoint.CancelOrder, body, signerAddr, &result); err != nil {
+		return nil, err
 	}
-
-	return nil
-}
-
-func (c *ClobClient) CreateOrDeriveApiKey() {
-
+	return &result, nil
 }
 
-func (c *ClobClient) GetOrders(orderIds []string) {
-
+// CancelOrders cancels the given orders. signerAddr is the address the API key belongs to;
+// for a PrivateKey signer it may be left zero.
+func (c *ClobClient) CancelOrders(orderIds []string, signerAddr common.Address) (*types.CancelOrdersResponse, error) {
+	return c.CancelOrdersWithContext(context.Background(), orderIds, signerAddr)
 }
 
-func (c *ClobClient) CancelOrder(orderId string, signerAddr common.Address) (*types.OrderResponse, error) {
-	err := c.AssertL2Auth()
-	if err != nil {
-		return nil, err
+func (c *ClobClient) CancelOrdersWithContext(ctx context.Context, orderIds []string, signerAddr common.Address) (*types.CancelOrdersResponse, error) {
+	if len(orderIds) == 0 {
+		return nil, fmt.Errorf("no order ids to cancel")
 	}
-	body := make(map[string]string)
-	body["orderId"] = orderId
-	bodyJs, err := sonic.MarshalString(body)
-	if err != nil {
+	var result types.CancelOrdersResponse
+	if err := c.cancelWithL2(ctx, endpoint.CancelOrders, orderIds, signerAddr, &result); err != nil {
 		return nil, err
 	}
-	args := &types.L2HeaderArgs{
-		Method:         "DELETE",
-		RequestPath:    endpoint.CancelOrder,
-		Body:           bodyJs,
-		SerializedBody: bodyJs,
-	}
+	return &result, nil
+}
 
-	timestamp, err := c.GetServerTime()
-	if err != nil {
+func (c *ClobClient) CancelAllOrders(signerAddr common.Address) (*types.OrderResponse, error) {
+	return c.CancelAllOrdersWithContext(context.Background(), signerAddr)
+}
+
+func (c *ClobClient) CancelAllOrdersWithContext(ctx context.Context, signerAddr common.Address) (*types.OrderResponse, error) {
+	var result types.OrderResponse
+	if err := c.cancelWithL2(ctx, endpoint.CancelAll, nil, signerAddr, &result); err != nil {
 		return nil, err
 	}
-	tsStr := strconv.FormatInt(timestamp, 10)
-	l2Headers, err := headers.CreateL2Headers(signerAddr, c.creds, args, tsStr)
-	if err != nil {
-		return nil, err
+	return &result, nil
+}
+
+// CancelMarketOrders cancels every open order of a market, of an asset, or of both when both are set.
+func (c *ClobClient) CancelMarketOrders(params types.OrderMarketCancelParams, signerAddr common.Address) (*types.CancelOrdersResponse, error) {
+	return c.CancelMarketOrdersWithContext(context.Background(), params, signerAddr)
+}
+
+func (c *ClobClient) CancelMarketOrdersWithContext(ctx context.Context, params types.OrderMarketCancelParams, signerAddr common.Address) (*types.CancelOrdersResponse, error) {
+	if (params.Market == nil || *params.Market == "") && (params.AssetID == nil || *params.AssetID == "") {
+		return nil, fmt.Errorf("market or asset id is required")
 	}
-	var result types.OrderResponse
-	err = c.deleteWithHeaders(endpoint.CancelOrder, l2Headers, bodyJs, &result)
-	if err != nil {
+	var result types.CancelOrdersResponse
+	if err := c.cancelWithL2(ctx, endpoint.CancelMarketOrders, params, signerAddr, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (c *ClobClient) CancelOrders() {
+// cancelWithL2 sends an L2 authenticated DELETE to a cancel endpoint, signed with the
+// trading timestamp.
+func (c *ClobClient) cancelWithL2(ctx context.Context, path string, body interface{}, signerAddr common.Address, result interface{}) error {
+	return c.sendWithL2(ctx, "DELETE", path, nil, body, signerAddr, true, result)
+}
 
+// postWithL2 sends an L2 authenticated POST.
+func (c *ClobClient) postWithL2(ctx context.Context, path string, body interface{}, signerAddr common.Address, result interface{}) error {
+	return c.sendWithL2(ctx, "POST", path, nil, body, signerAddr, false, result)
 }
 
-func (c *ClobClient) CancelAllOrders(signerAddr common.Address) (*types.OrderResponse, error) {
+// sendWithL2 serializes the body once so the HMAC is computed over exactly the bytes that are sent.
+// Like for GET, the query params are not part of the signed path. Trading requests are signed
+// with tradingTimestamp.
+func (c *ClobClient) sendWithL2(ctx context.Context, method string, path string, params url.Values, body interface{}, signerAddr common.Address, trading bool, result interface{}) error {
 	if err := c.AssertL2Auth(); err != nil {
-		return nil, err
+		return err
+	}
+	addr, err := c.l2Address(signerAddr)
+	if err != nil {
+		return err
 	}
 	bodyJs := ""
-
+	if body != nil {
+		bodyJs, err = serializeJsonBody(body)
+		if err != nil {
+			return err
+		}
+	}
 	args := &types.L2HeaderArgs{
-		Method:         "DELETE",
-		RequestPath:    endpoint.CancelAll,
+		Method:         method,
+		RequestPath:    path,
 		Body:           bodyJs,
 		SerializedBody: bodyJs,
 	}
 
-	timestamp, err := c.GetServerTime()
+	ts := c.headerTimestamp(ctx)
+	if trading {
+		if ts, err = c.tradingTimestamp(ctx); err != nil {
+			return err
+		}
+	}
+	l2Headers, err := headers.CreateL2Headers(addr, c.apiCreds(), args, ts)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	var data interface{}
+	if bodyJs != "" {
+		data = bodyJs
+	}
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+	if method == "POST" {
+		return c.postJSONWithHeaders(ctx, path, l2Headers, data, result)
 	}
-	tsStr := strconv.FormatInt(timestamp, 10)
+	return c.deleteWithHeaders(ctx, path, l2Headers, data, result)
+}
 
-	l2Headers, err := headers.CreateL2Headers(signerAddr, c.creds, args, tsStr)
+// l2Get sends an L2 authenticated GET. The signature covers the path without the query.
+func (c *ClobClient) l2Get(ctx context.Context, path string, signerAddr common.Address, params url.Values, result interface{}) error {
+	if err := c.AssertL2Auth(); err != nil {
+		return err
+	}
+	addr, err := c.l2Address(signerAddr)
 	if err != nil {
-		return nil, err
+		return err
 	}
-
-	var result types.OrderResponse
-	if err := c.deleteWithHeaders(endpoint.CancelAll, l2Headers, bodyJs, &result); err != nil {
-		return nil, err
+	headers, err := c.createL2Headers(ctx, addr, &types.L2HeaderArgs{
+		Method:      "GET",
+		RequestPath: path,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create L2 headers: %w", err)
 	}
+	return c.getJSONWithHeadersAndParams(ctx, path, headers, params, result)
+}
 
-	return &result, nil
+// l2Address returns the address L2 headers are issued for. A PrivateKey signer falls
+// back to its own address; a Turnkey signer needs the Turnkey account explicitly.
+func (c *ClobClient) l2Address(addr common.Address) (common.Address, error) {
+	if addr != constants.ZERO_ADDRESS {
+		return addr, nil
+	}
+	if c.signer.SignerType() == signer.Turnkey {
+		return common.Address{}, fmt.Errorf("turnkeyAccount is required")
+	}
+	return c.signer.GetPubkeyOfPrivateKey()
 }
 
 func (c *ClobClient) CreateAndPostMarketOrder(args clob_types.MarketOrderArgs, option clob_types.PartialCreateOrderOptions) (*types.OrderResponse, error) {
-	argStr, err := sonic.MarshalString(args)
-	if err != nil {
-		return nil, err
-	}
-	log.Printf("CreateAndPostMarketOrder arg:%v\n", argStr)
-	signedOrder, err := c.createMarketOrder(args, option)
+	return c.CreateAndPostMarketOrderWithContext(context.Background(), args, option)
+}
+
+func (c *ClobClient) CreateAndPostMarketOrderWithContext(ctx context.Context, args clob_types.MarketOrderArgs, option clob_types.PartialCreateOrderOptions) (*types.OrderResponse, error) {
+	c.logger.Debug("create and post market order", "token_id", args.TokenID, "side", args.Side, "amount", args.Amount, "price", args.Price)
+	// the order is posted with the type its price was computed for
+	option.OrderType = marketOrderType(args, option)
+	signedOrder, err := c.createMarketOrder(ctx, args, option)
 	if err != nil {
 		return nil, err
 	}
 
-	return c.postOrder(signedOrder, option)
+	return c.postOrder(ctx, signedOrder, option)
+}
+
+// marketOrderType is the type of a market order: args.OrderType, else option.OrderType,
+// else FOK.
+func marketOrderType(args clob_types.MarketOrderArgs, option clob_types.PartialCreateOrderOptions) types.OrderType {
+	if args.OrderType != "" {
+		return args.OrderType
+	}
+	if option.OrderType != "" {
+		return option.OrderType
+	}
+	return types.OrderTypeFOK
 }
 
-func (c *ClobClient) createMarketOrder(args clob_types.MarketOrderArgs, option clob_types.PartialCreateOrderOptions) (utils_order_builder.SignedOrder, error) {
+func (c *ClobClient) createMarketOrder(ctx context.Context, args clob_types.MarketOrderArgs, option clob_types.PartialCreateOrderOptions) (utils_order_builder.SignedOrder, error) {
 	err := c.AssertL1Auth()
 	if err != nil {
 		return utils_order_builder.SignedOrder{}, err
 	}
 	if option.TickSize == nil {
-		tickSize, err := c.GetTickSize(args.TokenID)
+		tickSize, err := c.GetTickSizeWithContext(ctx, args.TokenID)
 		if err != nil {
 			return utils_order_builder.SignedOrder{}, err
 		}
 		option.TickSize = &tickSize
 	}
-	err = c.priceValid(args.Price, *option.TickSize)
-	if err != nil {
+	args.OrderType = marketOrderType(args, option)
+	if args.Price.IsZero() {
+		book, err := c.GetOrderBookWithContext(ctx, args.TokenID)
+		if err != nil {
+			return utils_order_builder.SignedOrder{}, err
+		}
+		price, best, err := marketPriceFromBook(book, args.Side, args.Amount, args.OrderType)
+		if err != nil {
+			return utils_order_builder.SignedOrder{}, err
+		}
+		if err := checkSlippage(args.Side, price, best, args.MaxSlippage); err != nil {
+			return utils_order_builder.SignedOrder{}, err
+		}
+		args.Price = price
+	}
+	if err := preflightError(ValidateMarketOrder(args, option, c.preflightContext(args.TokenID, *option.TickSize))); err != nil {
 		return utils_order_builder.SignedOrder{}, err
 	}
 	if option.NegRisk == nil {
-		isNegRisk, err := c.GetNegRisk(args.TokenID)
+		isNegRisk, err := c.GetNegRiskWithContext(ctx, args.TokenID)
 		if err != nil {
 			return utils_order_builder.SignedOrder{}, err
 		}
 		option.NegRisk = &isNegRisk
 	}
 
-	feeRateBps, err := c.ResolveFeeRateBps(args.TokenID, args.FeeRateBps)
+	feeRateBps, err := c.ResolveFeeRateBpsWithContext(ctx, args.TokenID, args.FeeRateBps)
 	if err != nil {
 		return utils_order_builder.SignedOrder{}, err
 	}
 	args.FeeRateBps = feeRateBps
-	if args.OrderType == "" {
-		args.OrderType = types.OrderTypeFOK
-	}
-	var funder common.Address
-	var signatureType constants.SigType
-	if c.signer.SignerType() == signer.Turnkey {
-		signatureType = constants.POLY_GNOSIS_SAFE
-		funder = option.SafeAccount
 
-	} else if c.signer.SignerType() == signer.PrivateKey {
-		signatureType = constants.EOA
-		funder = common.HexToAddress(c.signer.Address())
+	orderBuilder, err := c.newOrderBuilder(option)
+	if err != nil {
+		return utils_order_builder.SignedOrder{}, err
 	}
-
-	orderBuilder, err := order_builder.NewOrderBuilder(c.signer, signatureType, funder)
+	if err := ctx.Err(); err != nil {
+		return utils_order_builder.SignedOrder{}, err
+	}
+	order, err := orderBuilder.CreateMarketOrder(c.signer, args, option)
 	if err != nil {
 		return utils_order_builder.SignedOrder{}, err
 	}
-	return orderBuilder.CreateMarketOrder(c.signer, args, option)
-}
-
-func (c *ClobClient) CancelMarketOrders() {}
-
-func (c *ClobClient) GetBuilderTrades() {
-
+	if err := c.verifySignedOrder(order, option, args.Price); err != nil {
+		return utils_order_builder.SignedOrder{}, err
+	}
+	return order, nil
 }
@@ -1,8 +1,17 @@
 package clob
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -14,9 +23,11 @@ import (
 	config2 "github.com/ybina/polymarket-go/client/config"
 	"github.com/ybina/polymarket-go/client/constants"
 	"github.com/ybina/polymarket-go/client/relayer/builder"
+	"github.com/ybina/polymarket-go/client/retry"
 	"github.com/ybina/polymarket-go/client/signer"
 	"github.com/ybina/polymarket-go/client/types"
 	"github.com/ybina/polymarket-go/tools/headers"
+	"github.com/ybina/polymarket-go/tools/logging"
 	"github.com/ybina/polymarket-go/turnkey"
 )
 
@@ -651,3 +662,359 @@ func TestClobClient_GetTickSize(t *testing.T) {
 	}
 	log.Printf("Tick size: %v\n", tickSize)
 }
+
+func TestClobClient_GetOrderBookWithContext_Canceled(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	clobClient, err := NewClobClient(&ClientConfig{Host: srv.URL, ChainID: types.ChainPolygon})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = clobClient.GetOrderBookWithContext(ctx, "1")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestClobClient_CancelOrders(t *testing.T) {
+	var clobClient *ClobClient
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/time":
+			_, _ = w.Write([]byte("1700000000"))
+		case "/orders", "/cancel-market-orders":
+			if r.Method != http.MethodDelete {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			body, _ := io.ReadAll(r.Body)
+			// the signature must cover the exact body that was sent
+			want, err := headers.CreateL2Headers(common.HexToAddress(r.Header.Get("POLY_ADDRESS")), clobClient.apiCreds(), &types.L2HeaderArgs{
+				Method:         "DELETE",
+				RequestPath:    r.URL.Path,
+				Body:           string(body),
+				SerializedBody: string(body),
+			}, r.Header.Get("POLY_TIMESTAMP"))
+			if err != nil || want.POLYSignature != r.Header.Get("POLY_SIGNATURE") {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			if r.URL.Path == "/orders" && string(body) != `["0x1","0x2"]` {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			if r.URL.Path == "/cancel-market-orders" && string(body) != `{"asset_id":"123"}` {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			_, _ = w.Write([]byte(`{"canceled":["0x1"],"not_canceled":{"0x2":"order not found"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	clobClient = newTestPrivateKeyClient(t, srv.URL)
+
+	resp, err := clobClient.CancelOrders([]string{"0x1", "0x2"}, common.Address{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Canceled) != 1 || resp.Canceled[0] != "0x1" || resp.NotCanceled["0x2"] != "order not found" {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+
+	assetID := "123"
+	resp, err = clobClient.CancelMarketOrders(types.OrderMarketCancelParams{AssetID: &assetID}, common.Address{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Canceled) != 1 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+
+	if _, err := clobClient.CancelMarketOrders(types.OrderMarketCancelParams{}, common.Address{}); err == nil {
+		t.Fatal("expected error without market or asset id")
+	}
+}
+
+func TestClobClient_MarketDataTyped(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/markets/0xabc":
+			_, _ = w.Write([]byte(`{"condition_id":"0xabc","minimum_tick_size":0.01,"neg_risk":true,"tokens":[{"token_id":"1","outcome":"Yes","price":0.45}]}`))
+		case "/midpoint":
+			_, _ = w.Write([]byte(`{"mid":"0.455"}`))
+		case "/midpoints":
+			_, _ = w.Write([]byte(`{"1":"0.455","2":"0.545"}`))
+		case "/prices":
+			_, _ = w.Write([]byte(`{"1":{"BUY":"0.45","SELL":"0.46"}}`))
+		case "/last-trade-price":
+			_, _ = w.Write([]byte(`{"price":"0.45","side":"BUY"}`))
+		case "/last-trades-prices":
+			_, _ = w.Write([]byte(`[{"token_id":"1","price":"0.45","side":"BUY"},{"token_id":"2","price":"0.55","side":"SELL"}]`))
+		case "/spread":
+			_, _ = w.Write([]byte(`{"spread":"0.02"}`))
+		case "/spreads":
+			_, _ = w.Write([]byte(`{"1":"0.02","2":"0.1"}`))
+		case "/prices-history":
+			_, _ = w.Write([]byte(`{"history":[{"t":1700000000,"p":0.45},{"t":1700000060,"p":0.46}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":"not found"}`))
+		}
+	}))
+	defer srv.Close()
+
+	clobClient, err := NewClobClient(&ClientConfig{Host: srv.URL, ChainID: types.ChainPolygon})
+	if err != nil {
+		t.Fatal(err)
+	}
+	market, err := clobClient.GetMarket("0xabc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !market.NegRisk || !market.MinimumTickSize.Equal(decimal.RequireFromString("0.01")) || market.Tokens[0].TokenID != "1" {
+		t.Fatalf("unexpected market: %+v", market)
+	}
+	mid, err := clobClient.GetMidpoint("1")
+	if err != nil || !mid.Equal(decimal.RequireFromString("0.455")) {
+		t.Fatalf("midpoint: %v %v", mid, err)
+	}
+	mids, err := clobClient.GetMidpoints([]types.BookParams{{TokenID: "1"}, {TokenID: "2"}})
+	if err != nil || !mids["2"].Equal(decimal.RequireFromString("0.545")) {
+		t.Fatalf("midpoints: %v %v", mids, err)
+	}
+	prices, err := clobClient.GetPrices([]types.BookParams{{TokenID: "1", Side: types.SideBuy}})
+	if err != nil || !prices["1"][types.SideSell].Equal(decimal.RequireFromString("0.46")) {
+		t.Fatalf("prices: %v %v", prices, err)
+	}
+	last, err := clobClient.GetLastTradePrice("1")
+	if err != nil || last.Side != types.SideBuy || last.TokenID != "1" {
+		t.Fatalf("last trade price: %+v %v", last, err)
+	}
+	lasts, err := clobClient.GetLastTradesPrices([]types.BookParams{{TokenID: "1"}, {TokenID: "2"}})
+	if err != nil || lasts["2"].Side != types.SideSell {
+		t.Fatalf("last trades prices: %v %v", lasts, err)
+	}
+	spread, err := clobClient.GetSpread("1")
+	if err != nil || !spread.Equal(decimal.RequireFromString("0.02")) {
+		t.Fatalf("spread: %v %v", spread, err)
+	}
+	spreads, err := clobClient.GetSpreads([]types.BookParams{{TokenID: "1"}, {TokenID: "2"}})
+	if err != nil || !spreads["2"].Equal(decimal.RequireFromString("0.1")) {
+		t.Fatalf("spreads: %v %v", spreads, err)
+	}
+	history, err := clobClient.GetPricesHistory(types.PriceHistoryFilterParams{})
+	if err != nil || len(history) != 2 || history[1].P != 0.46 {
+		t.Fatalf("prices history: %v %v", history, err)
+	}
+	if _, err := clobClient.GetMarket("0xdef"); err == nil {
+		t.Fatal("expected error for unknown market")
+	}
+}
+
+func TestClobClient_APIError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-42")
+		switch r.URL.Path {
+		case "/time":
+			_, _ = w.Write([]byte("1700000000"))
+		case "/book":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":"No orderbook exists for the requested token id"}`))
+		case "/orders":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error":"Trading restricted in your region, please refer to available regions"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	clobClient := newTestPrivateKeyClient(t, srv.URL)
+
+	_, err := clobClient.GetOrderBook("1")
+	var apiErr *types.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+	if apiErr.StatusCode != http.StatusNotFound || apiErr.Endpoint != "/book" || apiErr.RequestID != "req-42" {
+		t.Fatalf("unexpected error: %+v", apiErr)
+	}
+
+	_, err = clobClient.postOrders(context.Background(), nil, clob_types.PartialCreateOrderOptions{})
+	if !errors.As(err, &apiErr) || !apiErr.IsGeoblocked() || apiErr.Retryable() {
+		t.Fatalf("expected geoblock error, got %v", err)
+	}
+}
+
+func TestClobClient_Retry(t *testing.T) {
+	var bookCalls, booksCalls, orderCalls, tradeCalls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/time":
+			_, _ = w.Write([]byte("1700000000"))
+		case "/books":
+			booksCalls++
+			if booksCalls == 1 {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			_, _ = w.Write([]byte(`[]`))
+		case "/book":
+			bookCalls++
+			if bookCalls == 1 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = w.Write([]byte(`{"market":"0x1","asset_id":"1","bids":[],"asks":[]}`))
+		case "/orders":
+			orderCalls++
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case "/data/trades":
+			tradeCalls++
+			if r.URL.Query().Get("next_cursor") == "MQ==" {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":"invalid cursor"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":[{"id":"t1"}],"next_cursor":"MQ=="}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	clobClient := newTestPrivateKeyClient(t, srv.URL)
+	clobClient.retryPolicy = &retry.Policy{MaxAttempts: 3, InitialBackoff: time.Millisecond, Multiplier: 2}
+
+	if _, err := clobClient.GetOrderBook("1"); err != nil || bookCalls != 2 {
+		t.Fatalf("expected the book to be fetched on retry, calls %d, err %v", bookCalls, err)
+	}
+	if _, err := clobClient.GetOrderBooks([]types.BookParams{{TokenID: "1"}}); err != nil || booksCalls != 2 {
+		t.Fatalf("expected read-only POSTs to be retried, calls %d, err %v", booksCalls, err)
+	}
+
+	_, err := clobClient.postOrders(context.Background(), nil, clob_types.PartialCreateOrderOptions{})
+	if err == nil || orderCalls != 1 {
+		t.Fatalf("orders must not be retried by default, calls %d, err %v", orderCalls, err)
+	}
+	clobClient.retryPolicy.RetryNonIdempotent = true
+	_, _ = clobClient.postOrders(context.Background(), nil, clob_types.PartialCreateOrderOptions{})
+	if orderCalls != 4 {
+		t.Fatalf("expected orders to be retried on opt in, calls %d", orderCalls)
+	}
+
+	trades, err := clobClient.GetTrades(common.Address{}, nil, false, "")
+	if err == nil || trades != nil {
+		t.Fatalf("expected page errors to be returned, got %v, %v", trades, err)
+	}
+	if tradeCalls != 2 {
+		t.Fatalf("expected 2 trade pages to be requested, got %d", tradeCalls)
+	}
+}
+
+func TestClobClient_ServerClock(t *testing.T) {
+	serverTime := time.Now().Add(-time.Hour).Unix()
+	var timeCalls int
+	var timestamps []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/time":
+			timeCalls++
+			_, _ = fmt.Fprintf(w, "%d", serverTime)
+		case "/cancel-all":
+			timestamps = append(timestamps, r.Header.Get("POLY_TIMESTAMP"))
+			_, _ = w.Write([]byte(`{"canceled":[]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	clobClient := newTestPrivateKeyClient(t, srv.URL)
+	clobClient.clock = headers.NewClockSync(clobClient.GetServerTimeWithContext, time.Hour)
+
+	for i := 0; i < 3; i++ {
+		if _, err := clobClient.CancelAllOrders(common.Address{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if timeCalls != 1 {
+		t.Fatalf("expected the server time to be fetched once, got %d", timeCalls)
+	}
+	for _, ts := range timestamps {
+		signed, _ := strconv.ParseInt(ts, 10, 64)
+		if signed < serverTime || signed > serverTime+2 {
+			t.Fatalf("expected server based timestamps, got %s for server time %d", ts, serverTime)
+		}
+	}
+	if offset := clobClient.ClockOffset(); offset > -59*time.Minute || offset < -61*time.Minute {
+		t.Fatalf("unexpected offset %s", offset)
+	}
+}
+
+func TestClobClient_TradingTimestamp(t *testing.T) {
+	var cancelTimestamp, notificationsTimestamp string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/time":
+			_, _ = w.Write([]byte("1700000000"))
+		case "/cancel-all":
+			cancelTimestamp = r.Header.Get("POLY_TIMESTAMP")
+			_, _ = w.Write([]byte(`{"canceled":[]}`))
+		case "/notifications":
+			notificationsTimestamp = r.Header.Get("POLY_TIMESTAMP")
+		}
+	}))
+	defer srv.Close()
+
+	// without UseServerTime, trading requests are still signed with the server time
+	clobClient := newTestPrivateKeyClient(t, srv.URL)
+	if _, err := clobClient.CancelAllOrders(common.Address{}); err != nil {
+		t.Fatal(err)
+	}
+	if cancelTimestamp != "1700000000" {
+		t.Fatalf("expected the server time, got %q", cancelTimestamp)
+	}
+	if err := clobClient.DropNotifications(types.DropNotificationParams{IDs: []string{"1"}}, common.Address{}); err != nil {
+		t.Fatal(err)
+	}
+	if notificationsTimestamp == "1700000000" {
+		t.Fatal("other requests must use the local clock")
+	}
+}
+
+func TestClobClient_Logger(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/time" {
+			_, _ = w.Write([]byte("1700000000"))
+			return
+		}
+		_, _ = w.Write([]byte(`{"canceled":[]}`))
+	}))
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	clobClient := newTestPrivateKeyClient(t, srv.URL)
+	clobClient.logger = logging.New(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	if _, err := clobClient.CancelAllOrders(common.Address{}); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	if !strings.Contains(out, "endpoint=/cancel-all") || !strings.Contains(out, "status=200") {
+		t.Fatalf("expected a request log, got %s", out)
+	}
+	if strings.Contains(out, "test-passphrase") || strings.Contains(out, "test-key") {
+		t.Fatalf("credentials leaked: %s", out)
+	}
+}
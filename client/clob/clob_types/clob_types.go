@@ -25,7 +25,10 @@ type OrderArgs struct {
 }
 
 type PartialCreateOrderOptions struct {
-	OrderType      types.OrderType `json:"orderType"`
+	OrderType types.OrderType `json:"orderType"`
+	// PostOnly rests the order without matching; the exchange rejects it if it would cross
+	// the book. Only GTC and GTD orders can be post-only.
+	PostOnly       bool            `json:"postOnly,omitempty"`
 	TickSize       *types.TickSize `json:"tickSize"`
 	NegRisk        *bool           `json:"negRisk"`
 	TurnkeyAccount common.Address  `json:"turnkeyAccount"`
@@ -53,4 +56,8 @@ type MarketOrderArgs struct {
 	Taker common.Address `json:"taker"`
 
 	OrderType types.OrderType `json:"order_type"`
+
+	// MaxSlippage bounds how far the price computed from the order book may move away
+	// from the best price, as a fraction (0.02 is 2%). Zero disables the check.
+	MaxSlippage decimal.Decimal `json:"max_slippage"`
 }
@@ -0,0 +1,283 @@
+package clob
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ybina/polymarket-go/client/clob/clob_types"
+	"github.com/ybina/polymarket-go/client/signer"
+	"github.com/ybina/polymarket-go/client/types"
+)
+
+// ErrCredentialsNotFound is returned by a CredentialStore that has no credentials for an address.
+var ErrCredentialsNotFound = errors.New("credentials not found")
+
+// CredentialStore persists API credentials keyed by the address that owns the API key
+// (the private key address or the Turnkey account).
+type CredentialStore interface {
+	Load(addr common.Address) (*types.ApiKeyCreds, error)
+	Save(addr common.Address, creds *types.ApiKeyCreds) error
+}
+
+// CreateOrDeriveApiKey returns the API credentials of the signer. It tries the configured
+// CredentialStore first, then derives the existing key and creates one when the nonce has
+// no key yet.
+// The credentials are saved to the store and used by the client when it has none yet.
+func (c *ClobClient) CreateOrDeriveApiKey(nonce *uint64, option clob_types.ClobOption) (*types.ApiKeyCreds, error) {
+	return c.CreateOrDeriveApiKeyWithContext(context.Background(), nonce, option)
+}
+
+func (c *ClobClient) CreateOrDeriveApiKeyWithContext(ctx context.Context, nonce *uint64, option clob_types.ClobOption) (*types.ApiKeyCreds, error) {
+	if c.signer == nil {
+		return nil, fmt.Errorf("signer is required to derive API key")
+	}
+	var addr common.Address
+	if c.signer.SignerType() == signer.Turnkey {
+		if option.TurnkeyAccount == (common.Address{}) {
+			return nil, fmt.Errorf("turnkeyAccount is required")
+		}
+		addr = option.TurnkeyAccount
+	} else {
+		var err error
+		if addr, err = c.signer.GetPubkeyOfPrivateKey(); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.credStore != nil {
+		creds, err := c.credStore.Load(addr)
+		if err == nil && creds != nil {
+			c.useCreds(creds)
+			return creds, nil
+		}
+		if err != nil && !errors.Is(err, ErrCredentialsNotFound) {
+			return nil, fmt.Errorf("failed to load credentials: %w", err)
+		}
+	}
+
+	creds, err := c.DeriveApiKeyWithContext(ctx, nonce, option)
+	if err != nil || creds.Key == "" {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		// only create a key when the nonce has none yet, not when deriving was rejected
+		var apiErr *types.APIError
+		if err != nil && !(errors.As(err, &apiErr) && apiErr.Kind() == types.ErrorKindNotFound) {
+			return nil, fmt.Errorf("failed to derive API key: %w", err)
+		}
+		creds, err = c.CreateApiKeyWithContext(ctx, nonce, option)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create API key: %w", err)
+		}
+	}
+
+	if c.credStore != nil {
+		if err := c.credStore.Save(addr, creds); err != nil {
+			return nil, fmt.Errorf("failed to save credentials: %w", err)
+		}
+	}
+	c.useCreds(creds)
+	return creds, nil
+}
+
+// HasApiCreds reports whether the client has L2 credentials.
+func (c *ClobClient) HasApiCreds() bool {
+	creds := c.apiCreds()
+	return creds != nil && creds.Key != ""
+}
+
+// apiCreds returns the L2 credentials of the client, nil when it has none.
+func (c *ClobClient) apiCreds() *types.ApiKeyCreds {
+	return c.creds.Load()
+}
+
+// useCreds sets the L2 credentials unless the client already has some. Requests may read
+// the credentials concurrently.
+func (c *ClobClient) useCreds(creds *types.ApiKeyCreds) {
+	for {
+		old := c.creds.Load()
+		if old != nil && old.Key != "" {
+			return
+		}
+		if c.creds.CompareAndSwap(old, creds) {
+			return
+		}
+	}
+}
+
+// MemoryCredentialStore keeps credentials for the lifetime of the process.
+type MemoryCredentialStore struct {
+	mu    sync.RWMutex
+	creds map[common.Address]types.ApiKeyCreds
+}
+
+func NewMemoryCredentialStore() *MemoryCredentialStore {
+	return &MemoryCredentialStore{creds: make(map[common.Address]types.ApiKeyCreds)}
+}
+
+func (s *MemoryCredentialStore) Load(addr common.Address) (*types.ApiKeyCreds, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	creds, ok := s.creds[addr]
+	if !ok {
+		return nil, ErrCredentialsNotFound
+	}
+	return &creds, nil
+}
+
+func (s *MemoryCredentialStore) Save(addr common.Address, creds *types.ApiKeyCreds) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.creds[addr] = *creds
+	return nil
+}
+
+// FileCredentialStore keeps credentials in a JSON file readable only by the owner.
+// With an encryption key the file is sealed with AES-256-GCM.
+type FileCredentialStore struct {
+	mu   sync.Mutex
+	path string
+	aead cipher.AEAD
+}
+
+// NewFileCredentialStore stores credentials at path. encryptionKey is either empty, for a
+// plaintext file, or 32 bytes.
+func NewFileCredentialStore(path string, encryptionKey []byte) (*FileCredentialStore, error) {
+	s := &FileCredentialStore{path: path}
+	if len(encryptionKey) == 0 {
+		return s, nil
+	}
+	if len(encryptionKey) != 32 {
+		return nil, fmt.Errorf("encryption key must be 32 bytes, got %d", len(encryptionKey))
+	}
+	block, err := aes.NewCipher(encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	if s.aead, err = cipher.NewGCM(block); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *FileCredentialStore) Load(addr common.Address) (*types.ApiKeyCreds, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	all, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+	creds, ok := all[addr.Hex()]
+	if !ok {
+		return nil, ErrCredentialsNotFound
+	}
+	return &creds, nil
+}
+
+func (s *FileCredentialStore) Save(addr common.Address, creds *types.ApiKeyCreds) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	all, err := s.read()
+	if err != nil {
+		return err
+	}
+	all[addr.Hex()] = *creds
+	return s.write(all)
+}
+
+func (s *FileCredentialStore) read() (map[string]types.ApiKeyCreds, error) {
+	all := make(map[string]types.ApiKeyCreds)
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return all, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if s.aead != nil {
+		n := s.aead.NonceSize()
+		if len(data) < n {
+			return nil, fmt.Errorf("credential file %s is corrupted", s.path)
+		}
+		if data, err = s.aead.Open(nil, data[:n], data[n:], nil); err != nil {
+			return nil, fmt.Errorf("failed to decrypt credential file %s: %w", s.path, err)
+		}
+	}
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, fmt.Errorf("failed to parse credential file %s: %w", s.path, err)
+	}
+	return all, nil
+}
+
+func (s *FileCredentialStore) write(all map[string]types.ApiKeyCreds) error {
+	data, err := json.Marshal(all)
+	if err != nil {
+		return err
+	}
+	if s.aead != nil {
+		nonce := make([]byte, s.aead.NonceSize())
+		if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+			return err
+		}
+		data = s.aead.Seal(nonce, nonce, data, nil)
+	}
+	// write then rename so a crash never leaves a truncated file
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if err := tmp.Chmod(0o600); err != nil {
+		tmp.Close()
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path)
+}
+
+// EnvCredentialStore reads credentials from <Prefix>_API_KEY, <Prefix>_API_SECRET and
+// <Prefix>_API_PASSPHRASE. If <Prefix>_API_ADDRESS is set the credentials only apply to
+// that address. The store is read-only; Save is a no-op. Prefix defaults to POLY.
+type EnvCredentialStore struct {
+	Prefix string
+}
+
+func (s EnvCredentialStore) Load(addr common.Address) (*types.ApiKeyCreds, error) {
+	prefix := s.Prefix
+	if prefix == "" {
+		prefix = "POLY"
+	}
+	if owner := os.Getenv(prefix + "_API_ADDRESS"); owner != "" && !strings.EqualFold(common.HexToAddress(owner).Hex(), addr.Hex()) {
+		return nil, ErrCredentialsNotFound
+	}
+	creds := &types.ApiKeyCreds{
+		Key:        os.Getenv(prefix + "_API_KEY"),
+		Secret:     os.Getenv(prefix + "_API_SECRET"),
+		Passphrase: os.Getenv(prefix + "_API_PASSPHRASE"),
+	}
+	if creds.Key == "" || creds.Secret == "" || creds.Passphrase == "" {
+		return nil, ErrCredentialsNotFound
+	}
+	return creds, nil
+}
+
+func (s EnvCredentialStore) Save(common.Address, *types.ApiKeyCreds) error {
+	return nil
+}
@@ -0,0 +1,154 @@
+package clob
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ybina/polymarket-go/client/clob/clob_types"
+	"github.com/ybina/polymarket-go/client/signer"
+	"github.com/ybina/polymarket-go/client/types"
+)
+
+func TestClobClient_CreateOrDeriveApiKey(t *testing.T) {
+	var derives, creates atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("POLY_SIGNATURE") == "" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch {
+		case r.URL.Path == "/auth/derive-api-key":
+			derives.Add(1)
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"Could not derive api key!"}`))
+		case r.URL.Path == "/auth/api-key" && r.Method == http.MethodPost:
+			creates.Add(1)
+			_, _ = w.Write([]byte(`{"apiKey":"new-key","secret":"c2VjcmV0","passphrase":"pass"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	pk, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	signerHandler, err := signer.NewSigner(signer.SignerConfig{
+		SignerType:       signer.PrivateKey,
+		ChainID:          137,
+		PrivateKeyConfig: &signer.PrivateKeyClient{PrivateKey: pk},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := NewMemoryCredentialStore()
+	newClient := func() *ClobClient {
+		clobClient, err := NewClobClient(&ClientConfig{Host: srv.URL, ChainID: types.ChainPolygon, Signer: signerHandler, CredentialStore: store})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return clobClient
+	}
+
+	clobClient := newClient()
+	creds, err := clobClient.CreateOrDeriveApiKey(nil, clob_types.ClobOption{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if creds.Key != "new-key" || !clobClient.HasApiCreds() {
+		t.Fatalf("unexpected creds: %+v", creds)
+	}
+	if derives.Load() != 1 || creates.Load() != 1 {
+		t.Fatalf("expected derive then create, got %d derives %d creates", derives.Load(), creates.Load())
+	}
+
+	// a restarted client finds the credentials in the store
+	restarted := newClient()
+	creds, err = restarted.CreateOrDeriveApiKey(nil, clob_types.ClobOption{})
+	if err != nil || creds.Key != "new-key" {
+		t.Fatalf("creds from store: %+v %v", creds, err)
+	}
+	if derives.Load() != 1 || creates.Load() != 1 {
+		t.Fatal("expected no requests when credentials are stored")
+	}
+
+	// a rejected derive request must not mint a new key
+	unauthorized := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/api-key" {
+			creates.Add(1)
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error":"Unauthorized/Invalid api key"}`))
+	}))
+	defer unauthorized.Close()
+	rejected, err := NewClobClient(&ClientConfig{Host: unauthorized.URL, ChainID: types.ChainPolygon, Signer: signerHandler})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rejected.CreateOrDeriveApiKey(nil, clob_types.ClobOption{}); err == nil {
+		t.Fatal("expected the derive error")
+	}
+	if creates.Load() != 1 || rejected.HasApiCreds() {
+		t.Fatal("expected no key to be created after an unauthorized derive")
+	}
+}
+
+func TestFileCredentialStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "creds.json")
+	key := bytes.Repeat([]byte{7}, 32)
+	store, err := NewFileCredentialStore(path, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := common.HexToAddress("0x1")
+	if _, err := store.Load(addr); err != ErrCredentialsNotFound {
+		t.Fatalf("expected not found, got %v", err)
+	}
+	creds := &types.ApiKeyCreds{Key: "k", Secret: "s", Passphrase: "p"}
+	if err := store.Save(addr, creds); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(data, []byte(`"passphrase"`)) {
+		t.Fatal("credentials are stored in plaintext")
+	}
+
+	reopened, err := NewFileCredentialStore(path, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := reopened.Load(addr)
+	if err != nil || *loaded != *creds {
+		t.Fatalf("loaded %+v %v", loaded, err)
+	}
+	wrongKey, _ := NewFileCredentialStore(path, bytes.Repeat([]byte{8}, 32))
+	if _, err := wrongKey.Load(addr); err == nil {
+		t.Fatal("expected decryption error with the wrong key")
+	}
+}
+
+func TestEnvCredentialStore(t *testing.T) {
+	t.Setenv("TEST_API_KEY", "k")
+	t.Setenv("TEST_API_SECRET", "s")
+	t.Setenv("TEST_API_PASSPHRASE", "p")
+	t.Setenv("TEST_API_ADDRESS", "0x0000000000000000000000000000000000000001")
+	store := EnvCredentialStore{Prefix: "TEST"}
+	creds, err := store.Load(common.HexToAddress("0x1"))
+	if err != nil || creds.Key != "k" {
+		t.Fatalf("creds: %+v %v", creds, err)
+	}
+	if _, err := store.Load(common.HexToAddress("0x2")); err != ErrCredentialsNotFound {
+		t.Fatalf("expected not found for another address, got %v", err)
+	}
+}
@@ -0,0 +1,46 @@
+package clob
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// MinGTDExpiration is the security threshold of the exchange. It applies on top of
+// the requested lifetime: a GTD order stops matching MinGTDExpiration before its
+// expiration, so the expiration must be later than now plus the threshold.
+const MinGTDExpiration = time.Minute
+
+// GTDExpirationAt returns t as the expiration of a GTD order, or an error when t is not
+// later than MinGTDExpiration after now.
+func GTDExpirationAt(t, now time.Time) (int, error) {
+	if earliest := now.Add(MinGTDExpiration); !t.After(earliest) {
+		return 0, fmt.Errorf("GTD expiration %s must be more than %s after %s", t.UTC().Format(time.RFC3339), MinGTDExpiration, now.UTC().Format(time.RFC3339))
+	}
+	return int(t.Unix()), nil
+}
+
+// GTDExpirationIn returns the expiration of a GTD order that is live for d after now,
+// adding the security threshold to d.
+func GTDExpirationIn(d time.Duration, now time.Time) (int, error) {
+	return GTDExpirationAt(now.Add(MinGTDExpiration+d), now)
+}
+
+// GTDExpirationAt is GTDExpirationAt measured against the header clock of the client:
+// the synced server clock when UseServerTime is set, the local clock otherwise.
+func (c *ClobClient) GTDExpirationAt(t time.Time) (int, error) {
+	return c.GTDExpirationAtWithContext(context.Background(), t)
+}
+
+func (c *ClobClient) GTDExpirationAtWithContext(ctx context.Context, t time.Time) (int, error) {
+	return GTDExpirationAt(t, time.Unix(c.headerTime(ctx), 0))
+}
+
+// GTDExpirationIn is GTDExpirationIn measured against the header clock of the client.
+func (c *ClobClient) GTDExpirationIn(d time.Duration) (int, error) {
+	return c.GTDExpirationInWithContext(context.Background(), d)
+}
+
+func (c *ClobClient) GTDExpirationInWithContext(ctx context.Context, d time.Duration) (int, error) {
+	return GTDExpirationIn(d, time.Unix(c.headerTime(ctx), 0))
+}
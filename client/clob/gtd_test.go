@@ -0,0 +1,30 @@
+package clob
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGTDExpiration(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	got, err := GTDExpirationIn(90*time.Second, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// live for 90s on top of the one minute security threshold
+	if got != 1700000150 {
+		t.Fatalf("expected 1700000150, got %d", got)
+	}
+	if _, err := GTDExpirationIn(0, now); err == nil {
+		t.Fatal("expected an error for an order without lifetime")
+	}
+	if _, err := GTDExpirationAt(now.Add(MinGTDExpiration), now); err == nil {
+		t.Fatal("expected an error at the security threshold")
+	}
+	if got, err := GTDExpirationAt(now.Add(MinGTDExpiration+time.Second), now); err != nil || got != 1700000061 {
+		t.Fatalf("expected 1700000061, got %d, %v", got, err)
+	}
+	if _, err := GTDExpirationAt(now.Add(-time.Hour), now); err == nil {
+		t.Fatal("expected an error for a past expiration")
+	}
+}
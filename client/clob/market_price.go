@@ -0,0 +1,109 @@
+package clob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/shopspring/decimal"
+	"github.com/ybina/polymarket-go/client/types"
+)
+
+// ErrInsufficientLiquidity is returned when the book cannot fill a FOK market order.
+var ErrInsufficientLiquidity = errors.New("insufficient liquidity")
+
+// CalculateMarketPrice fetches the order book of tokenID and returns the worst price a
+// market order of amount would fill at. amount is in USDC for BUY and in shares for SELL.
+func (c *ClobClient) CalculateMarketPrice(tokenID string, side types.Side, amount decimal.Decimal, orderType types.OrderType) (decimal.Decimal, error) {
+	return c.CalculateMarketPriceWithContext(context.Background(), tokenID, side, amount, orderType)
+}
+
+func (c *ClobClient) CalculateMarketPriceWithContext(ctx context.Context, tokenID string, side types.Side, amount decimal.Decimal, orderType types.OrderType) (decimal.Decimal, error) {
+	book, err := c.GetOrderBookWithContext(ctx, tokenID)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	price, _, err := marketPriceFromBook(book, side, amount, orderType)
+	return price, err
+}
+
+// marketPriceFromBook walks the asks (BUY, by USDC amount) or the bids (SELL, by shares)
+// from the best level and returns the price of the level that completes the fill along
+// with the best price. When the book is too thin a FOK order fails with
+// ErrInsufficientLiquidity, a FAK order gets the worst price of the book and fills partially.
+func marketPriceFromBook(book *types.OrderBookSummary, side types.Side, amount decimal.Decimal, orderType types.OrderType) (price decimal.Decimal, best decimal.Decimal, err error) {
+	if !amount.IsPositive() {
+		return decimal.Decimal{}, decimal.Decimal{}, fmt.Errorf("amount must be positive")
+	}
+	var levels []types.OrderSummary
+	switch side {
+	case types.SideBuy:
+		levels = book.Asks
+	case types.SideSell:
+		levels = book.Bids
+	default:
+		return decimal.Decimal{}, decimal.Decimal{}, fmt.Errorf("invalid side: must be BUY or SELL")
+	}
+	if len(levels) == 0 {
+		return decimal.Decimal{}, decimal.Decimal{}, fmt.Errorf("%w: no %s liquidity for %s", ErrInsufficientLiquidity, side, book.AssetID)
+	}
+
+	type level struct{ price, size decimal.Decimal }
+	parsed := make([]level, 0, len(levels))
+	for _, l := range levels {
+		p, err := decimal.NewFromString(l.Price)
+		if err != nil {
+			return decimal.Decimal{}, decimal.Decimal{}, fmt.Errorf("invalid book price %q: %w", l.Price, err)
+		}
+		s, err := decimal.NewFromString(l.Size)
+		if err != nil {
+			return decimal.Decimal{}, decimal.Decimal{}, fmt.Errorf("invalid book size %q: %w", l.Size, err)
+		}
+		parsed = append(parsed, level{p, s})
+	}
+	// best level first: lowest ask, highest bid
+	sort.SliceStable(parsed, func(i, j int) bool {
+		if side == types.SideBuy {
+			return parsed[i].price.LessThan(parsed[j].price)
+		}
+		return parsed[i].price.GreaterThan(parsed[j].price)
+	})
+
+	best = parsed[0].price
+	sum := decimal.Zero
+	for _, l := range parsed {
+		if side == types.SideBuy {
+			sum = sum.Add(l.size.Mul(l.price))
+		} else {
+			sum = sum.Add(l.size)
+		}
+		if sum.GreaterThanOrEqual(amount) {
+			return l.price, best, nil
+		}
+	}
+	if orderType == types.OrderTypeFOK {
+		return decimal.Decimal{}, best, fmt.Errorf("%w: book of %s fills %s of %s", ErrInsufficientLiquidity, book.AssetID, sum.String(), amount.String())
+	}
+	return parsed[len(parsed)-1].price, best, nil
+}
+
+// checkSlippage fails when price is worse than best by more than maxSlippage (a fraction).
+func checkSlippage(side types.Side, price, best, maxSlippage decimal.Decimal) error {
+	if !maxSlippage.IsPositive() {
+		return nil
+	}
+	one := decimal.NewFromInt(1)
+	if side == types.SideBuy {
+		limit := best.Mul(one.Add(maxSlippage))
+		if price.GreaterThan(limit) {
+			return fmt.Errorf("max slippage exceeded: price %s above limit %s", price.String(), limit.String())
+		}
+		return nil
+	}
+	limit := best.Mul(one.Sub(maxSlippage))
+	if price.LessThan(limit) {
+		return fmt.Errorf("max slippage exceeded: price %s below limit %s", price.String(), limit.String())
+	}
+	return nil
+}
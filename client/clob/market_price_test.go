@@ -0,0 +1,109 @@
+package clob
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/ybina/polymarket-go/client/clob/clob_types"
+	"github.com/ybina/polymarket-go/client/types"
+)
+
+func TestMarketPriceFromBook(t *testing.T) {
+	// the exchange lists asks descending and bids ascending, best level last
+	book := &types.OrderBookSummary{
+		AssetID: "1",
+		Asks: []types.OrderSummary{
+			{Price: "0.60", Size: "100"},
+			{Price: "0.55", Size: "100"},
+			{Price: "0.50", Size: "100"},
+		},
+		Bids: []types.OrderSummary{
+			{Price: "0.40", Size: "100"},
+			{Price: "0.45", Size: "100"},
+			{Price: "0.48", Size: "100"},
+		},
+	}
+	d := decimal.RequireFromString
+
+	tests := []struct {
+		name      string
+		side      types.Side
+		amount    string
+		orderType types.OrderType
+		want      string
+		wantErr   error
+	}{
+		{"buy within best level", types.SideBuy, "50", types.OrderTypeFOK, "0.50", nil},
+		{"buy walks two levels", types.SideBuy, "100", types.OrderTypeFOK, "0.55", nil},
+		{"buy too large FOK", types.SideBuy, "200", types.OrderTypeFOK, "", ErrInsufficientLiquidity},
+		{"buy too large FAK", types.SideBuy, "200", types.OrderTypeFAK, "0.60", nil},
+		{"sell within best level", types.SideSell, "100", types.OrderTypeFOK, "0.48", nil},
+		{"sell walks three levels", types.SideSell, "250", types.OrderTypeFOK, "0.40", nil},
+		{"sell too large FOK", types.SideSell, "301", types.OrderTypeFOK, "", ErrInsufficientLiquidity},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			price, _, err := marketPriceFromBook(book, tt.side, d(tt.amount), tt.orderType)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !price.Equal(d(tt.want)) {
+				t.Fatalf("expected %s, got %s", tt.want, price)
+			}
+		})
+	}
+}
+
+func TestCheckSlippage(t *testing.T) {
+	d := decimal.RequireFromString
+	if err := checkSlippage(types.SideBuy, d("0.55"), d("0.50"), d("0.05")); err == nil {
+		t.Fatal("expected buy slippage error")
+	}
+	if err := checkSlippage(types.SideBuy, d("0.52"), d("0.50"), d("0.05")); err != nil {
+		t.Fatal(err)
+	}
+	if err := checkSlippage(types.SideSell, d("0.40"), d("0.48"), d("0.1")); err == nil {
+		t.Fatal("expected sell slippage error")
+	}
+	if err := checkSlippage(types.SideSell, d("0.40"), d("0.48"), decimal.Zero); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClobClient_CreateAndPostMarketOrder_OrderType(t *testing.T) {
+	exchange := newFakeExchange(t, map[string]string{
+		"/book":  `{"market":"0xc","asset_id":"1","bids":[],"asks":[{"price":"0.55","size":"100"},{"price":"0.50","size":"100"}]}`,
+		"/order": `{"success":true,"orderID":"0x1","status":"matched"}`,
+	})
+	clobClient := newTestPrivateKeyClient(t, exchange.URL)
+
+	tests := []struct {
+		name     string
+		argsType types.OrderType
+		optType  types.OrderType
+		wantType types.OrderType
+	}{
+		{"default", "", "", types.OrderTypeFOK},
+		{"args only", types.OrderTypeFAK, "", types.OrderTypeFAK},
+		{"option only", "", types.OrderTypeFAK, types.OrderTypeFAK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := clob_types.MarketOrderArgs{TokenID: "1", Amount: decimal.NewFromInt(10), Side: types.SideBuy, OrderType: tt.argsType}
+			if _, err := clobClient.CreateAndPostMarketOrder(args, testOrderOptions(tt.optType)); err != nil {
+				t.Fatal(err)
+			}
+			posted := exchange.Posted()
+			if len(posted) != 1 || posted[0].OrderType != string(tt.wantType) {
+				t.Fatalf("expected a %s order, got %+v", tt.wantType, posted)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package clob
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/ybina/polymarket-go/client/endpoint"
+	"github.com/ybina/polymarket-go/client/types"
+)
+
+// MarketCatalogOptions tunes the GetAll*Markets snapshots.
+type MarketCatalogOptions struct {
+	// Concurrency is the number of pages fetched in parallel. Zero or one fetches
+	// the pages one after another. Parallel fetching relies on the cursors being the
+	// base64 encoded item offset, as the CLOB returns them; when the first next_cursor
+	// does not decode to an offset, the pages are fetched one after another.
+	Concurrency int
+}
+
+func (c *ClobClient) GetMarkets(nextCursor string) (*types.MarketsPage, error) {
+	return c.GetMarketsWithContext(context.Background(), nextCursor)
+}
+
+func (c *ClobClient) GetMarketsWithContext(ctx context.Context, nextCursor string) (*types.MarketsPage, error) {
+	return c.getMarketsPage(ctx, endpoint.GetMarkets, nextCursor)
+}
+
+func (c *ClobClient) GetSamplingMarkets(nextCursor string) (*types.MarketsPage, error) {
+	return c.GetSamplingMarketsWithContext(context.Background(), nextCursor)
+}
+
+func (c *ClobClient) GetSamplingMarketsWithContext(ctx context.Context, nextCursor string) (*types.MarketsPage, error) {
+	return c.getMarketsPage(ctx, endpoint.GetSamplingMarkets, nextCursor)
+}
+
+func (c *ClobClient) GetSimplifiedMarkets(nextCursor string) (*types.SimplifiedMarketsPage, error) {
+	return c.GetSimplifiedMarketsWithContext(context.Background(), nextCursor)
+}
+
+func (c *ClobClient) GetSimplifiedMarketsWithContext(ctx context.Context, nextCursor string) (*types.SimplifiedMarketsPage, error) {
+	return c.getSimplifiedMarketsPage(ctx, endpoint.GetSimplifiedMarkets, nextCursor)
+}
+
+func (c *ClobClient) GetSamplingSimplifiedMarkets(nextCursor string) (*types.SimplifiedMarketsPage, error) {
+	return c.GetSamplingSimplifiedMarketsWithContext(context.Background(), nextCursor)
+}
+
+func (c *ClobClient) GetSamplingSimplifiedMarketsWithContext(ctx context.Context, nextCursor string) (*types.SimplifiedMarketsPage, error) {
+	return c.getSimplifiedMarketsPage(ctx, endpoint.GetSamplingSimplifiedMarkets, nextCursor)
+}
+
+func (c *ClobClient) IterMarkets(ctx context.Context) *Iterator[types.Market] {
+	return newIterator(ctx, types.INITIAL_CURSOR, c.marketsFetcher(endpoint.GetMarkets))
+}
+
+func (c *ClobClient) IterSamplingMarkets(ctx context.Context) *Iterator[types.Market] {
+	return newIterator(ctx, types.INITIAL_CURSOR, c.marketsFetcher(endpoint.GetSamplingMarkets))
+}
+
+func (c *ClobClient) IterSimplifiedMarkets(ctx context.Context) *Iterator[types.SimplifiedMarket] {
+	return newIterator(ctx, types.INITIAL_CURSOR, c.simplifiedMarketsFetcher(endpoint.GetSimplifiedMarkets))
+}
+
+func (c *ClobClient) IterSamplingSimplifiedMarkets(ctx context.Context) *Iterator[types.SimplifiedMarket] {
+	return newIterator(ctx, types.INITIAL_CURSOR, c.simplifiedMarketsFetcher(endpoint.GetSamplingSimplifiedMarkets))
+}
+
+// GetAllMarkets returns every market of the CLOB. opts may be nil.
+func (c *ClobClient) GetAllMarkets(opts *MarketCatalogOptions) ([]types.Market, error) {
+	return c.GetAllMarketsWithContext(context.Background(), opts)
+}
+
+func (c *ClobClient) GetAllMarketsWithContext(ctx context.Context, opts *MarketCatalogOptions) ([]types.Market, error) {
+	return collectPages(ctx, c.marketsFetcher(endpoint.GetMarkets), opts.concurrency())
+}
+
+// GetAllSamplingMarkets returns every market currently eligible for rewards. opts may be nil.
+func (c *ClobClient) GetAllSamplingMarkets(opts *MarketCatalogOptions) ([]types.Market, error) {
+	return c.GetAllSamplingMarketsWithContext(context.Background(), opts)
+}
+
+func (c *ClobClient) GetAllSamplingMarketsWithContext(ctx context.Context, opts *MarketCatalogOptions) ([]types.Market, error) {
+	return collectPages(ctx, c.marketsFetcher(endpoint.GetSamplingMarkets), opts.concurrency())
+}
+
+// GetAllSimplifiedMarkets returns every market of the CLOB in the simplified form. opts may be nil.
+func (c *ClobClient) GetAllSimplifiedMarkets(opts *MarketCatalogOptions) ([]types.SimplifiedMarket, error) {
+	return c.GetAllSimplifiedMarketsWithContext(context.Background(), opts)
+}
+
+func (c *ClobClient) GetAllSimplifiedMarketsWithContext(ctx context.Context, opts *MarketCatalogOptions) ([]types.SimplifiedMarket, error) {
+	return collectPages(ctx, c.simplifiedMarketsFetcher(endpoint.GetSimplifiedMarkets), opts.concurrency())
+}
+
+// GetAllSamplingSimplifiedMarkets returns every market currently eligible for rewards in the
+// simplified form. opts may be nil.
+func (c *ClobClient) GetAllSamplingSimplifiedMarkets(opts *MarketCatalogOptions) ([]types.SimplifiedMarket, error) {
+	return c.GetAllSamplingSimplifiedMarketsWithContext(context.Background(), opts)
+}
+
+func (c *ClobClient) GetAllSamplingSimplifiedMarketsWithContext(ctx context.Context, opts *MarketCatalogOptions) ([]types.SimplifiedMarket, error) {
+	return collectPages(ctx, c.simplifiedMarketsFetcher(endpoint.GetSamplingSimplifiedMarkets), opts.concurrency())
+}
+
+func (o *MarketCatalogOptions) concurrency() int {
+	if o == nil || o.Concurrency < 1 {
+		return 1
+	}
+	return o.Concurrency
+}
+
+func (c *ClobClient) getMarketsPage(ctx context.Context, path string, nextCursor string) (*types.MarketsPage, error) {
+	params := url.Values{}
+	if nextCursor != "" {
+		params.Add("next_cursor", nextCursor)
+	}
+	var result types.MarketsPage
+	if err := c.getJSONWithParams(ctx, path, params, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *ClobClient) getSimplifiedMarketsPage(ctx context.Context, path string, nextCursor string) (*types.SimplifiedMarketsPage, error) {
+	params := url.Values{}
+	if nextCursor != "" {
+		params.Add("next_cursor", nextCursor)
+	}
+	var result types.SimplifiedMarketsPage
+	if err := c.getJSONWithParams(ctx, path, params, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *ClobClient) marketsFetcher(path string) pageFetcher[types.Market] {
+	return func(ctx context.Context, cursor string) ([]types.Market, string, error) {
+		page, err := c.getMarketsPage(ctx, path, cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		return page.Data, page.NextCursor, nil
+	}
+}
+
+func (c *ClobClient) simplifiedMarketsFetcher(path string) pageFetcher[types.SimplifiedMarket] {
+	return func(ctx context.Context, cursor string) ([]types.SimplifiedMarket, string, error) {
+		page, err := c.getSimplifiedMarketsPage(ctx, path, cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		return page.Data, page.NextCursor, nil
+	}
+}
@@ -0,0 +1,120 @@
+package clob
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ybina/polymarket-go/client/types"
+)
+
+// newMarketsServer serves total markets in pages of pageSize with offset cursors like the CLOB.
+func newMarketsServer(t *testing.T, total, pageSize int, requests *atomic.Int32) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/markets" && r.URL.Path != "/sampling-simplified-markets" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		requests.Add(1)
+		offset := 0
+		if cursor := r.URL.Query().Get("next_cursor"); cursor != "" {
+			b, _ := base64.StdEncoding.DecodeString(cursor)
+			offset, _ = strconv.Atoi(string(b))
+		}
+		var data []string
+		for i := offset; i < total && i < offset+pageSize; i++ {
+			data = append(data, fmt.Sprintf(`{"condition_id":"%d","active":true}`, i))
+		}
+		next := types.END_CURSOR
+		if offset+pageSize < total {
+			next = base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(offset + pageSize)))
+		}
+		_, _ = fmt.Fprintf(w, `{"limit":%d,"count":%d,"next_cursor":"%s","data":[%s]}`, pageSize, len(data), next, strings.Join(data, ","))
+	}))
+}
+
+func TestClobClient_GetAllMarkets(t *testing.T) {
+	for _, concurrency := range []int{0, 3} {
+		t.Run(fmt.Sprintf("concurrency %d", concurrency), func(t *testing.T) {
+			var requests atomic.Int32
+			srv := newMarketsServer(t, 11, 2, &requests)
+			defer srv.Close()
+
+			clobClient, err := NewClobClient(&ClientConfig{Host: srv.URL, ChainID: types.ChainPolygon})
+			if err != nil {
+				t.Fatal(err)
+			}
+			markets, err := clobClient.GetAllMarkets(&MarketCatalogOptions{Concurrency: concurrency})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(markets) != 11 {
+				t.Fatalf("expected 11 markets, got %d", len(markets))
+			}
+			for i, m := range markets {
+				if m.ConditionID != strconv.Itoa(i) {
+					t.Fatalf("market %d out of order: %s", i, m.ConditionID)
+				}
+			}
+		})
+	}
+}
+
+func TestClobClient_IterSamplingSimplifiedMarkets(t *testing.T) {
+	var requests atomic.Int32
+	srv := newMarketsServer(t, 5, 2, &requests)
+	defer srv.Close()
+
+	clobClient, err := NewClobClient(&ClientConfig{Host: srv.URL, ChainID: types.ChainPolygon})
+	if err != nil {
+		t.Fatal(err)
+	}
+	it := clobClient.IterSamplingSimplifiedMarkets(context.Background())
+	n := 0
+	for it.Next() {
+		if !it.Value().Active {
+			t.Fatalf("unexpected market: %+v", it.Value())
+		}
+		n++
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if n != 5 || requests.Load() != 3 {
+		t.Fatalf("expected 5 markets in 3 pages, got %d in %d", n, requests.Load())
+	}
+}
+
+func TestCollectPages_OpaqueCursors(t *testing.T) {
+	// cursors that are not offsets are followed one page at a time
+	pages := map[string]struct {
+		items []int
+		next  string
+	}{
+		types.INITIAL_CURSOR: {[]int{0, 1}, "opaque-a"},
+		"opaque-a":           {[]int{2, 3}, "opaque-b"},
+		"opaque-b":           {[]int{4}, types.END_CURSOR},
+	}
+	var requests atomic.Int32
+	items, err := collectPages(context.Background(), func(ctx context.Context, cursor string) ([]int, string, error) {
+		requests.Add(1)
+		p, ok := pages[cursor]
+		if !ok {
+			return nil, "", fmt.Errorf("unexpected cursor %q", cursor)
+		}
+		return p.items, p.next, nil
+	}, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 5 || items[4] != 4 || requests.Load() != 3 {
+		t.Fatalf("unexpected items %v after %d requests", items, requests.Load())
+	}
+}
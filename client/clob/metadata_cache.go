@@ -0,0 +1,237 @@
+package clob
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ybina/polymarket-go/client/types"
+)
+
+const (
+	defaultTickSizeTTL = 5 * time.Minute
+	defaultNegRiskTTL  = 24 * time.Hour
+	defaultFeeRateTTL  = 5 * time.Minute
+	defaultMarketTTL   = time.Minute
+)
+
+// MetadataCacheConfig enables caching of the per-token tick size, neg-risk flag and fee rate
+// used when creating orders. A zero TTL uses the default for that kind.
+type MetadataCacheConfig struct {
+	TickSizeTTL time.Duration
+	NegRiskTTL  time.Duration
+	FeeRateTTL  time.Duration
+	// MarketTTL applies to the markets fetched by ValidateOrder, which orders being created
+	// are checked against for their minimum size and market status.
+	MarketTTL time.Duration
+}
+
+type metadataCache struct {
+	mu  sync.RWMutex
+	cfg MetadataCacheConfig
+	now func() time.Time
+
+	tickSizes   types.TickSizes
+	negRisk     types.NegRisk
+	feeRates    types.FeeRates
+	tickSizesAt map[string]time.Time
+	negRiskAt   map[string]time.Time
+	feeRatesAt  map[string]time.Time
+	markets     map[string]*types.Market
+	marketsAt   map[string]time.Time
+}
+
+func newMetadataCache(cfg MetadataCacheConfig) *metadataCache {
+	if cfg.TickSizeTTL == 0 {
+		cfg.TickSizeTTL = defaultTickSizeTTL
+	}
+	if cfg.NegRiskTTL == 0 {
+		cfg.NegRiskTTL = defaultNegRiskTTL
+	}
+	if cfg.FeeRateTTL == 0 {
+		cfg.FeeRateTTL = defaultFeeRateTTL
+	}
+	if cfg.MarketTTL == 0 {
+		cfg.MarketTTL = defaultMarketTTL
+	}
+	return &metadataCache{
+		cfg:         cfg,
+		now:         time.Now,
+		tickSizes:   make(types.TickSizes),
+		negRisk:     make(types.NegRisk),
+		feeRates:    make(types.FeeRates),
+		tickSizesAt: make(map[string]time.Time),
+		negRiskAt:   make(map[string]time.Time),
+		feeRatesAt:  make(map[string]time.Time),
+		markets:     make(map[string]*types.Market),
+		marketsAt:   make(map[string]time.Time),
+	}
+}
+
+func (m *metadataCache) fresh(at map[string]time.Time, tokenID string, ttl time.Duration) bool {
+	t, ok := at[tokenID]
+	return ok && m.now().Sub(t) < ttl
+}
+
+func (m *metadataCache) tickSize(tokenID string) (types.TickSize, bool) {
+	if m == nil {
+		return "", false
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if !m.fresh(m.tickSizesAt, tokenID, m.cfg.TickSizeTTL) {
+		return "", false
+	}
+	return m.tickSizes[tokenID], true
+}
+
+func (m *metadataCache) setTickSize(tokenID string, tickSize types.TickSize) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.tickSizes[tokenID] = tickSize
+	m.tickSizesAt[tokenID] = m.now()
+}
+
+func (m *metadataCache) isNegRisk(tokenID string) (bool, bool) {
+	if m == nil {
+		return false, false
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if !m.fresh(m.negRiskAt, tokenID, m.cfg.NegRiskTTL) {
+		return false, false
+	}
+	return m.negRisk[tokenID], true
+}
+
+func (m *metadataCache) setNegRisk(tokenID string, negRisk bool) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.negRisk[tokenID] = negRisk
+	m.negRiskAt[tokenID] = m.now()
+}
+
+func (m *metadataCache) feeRate(tokenID string) (int, bool) {
+	if m == nil {
+		return 0, false
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if !m.fresh(m.feeRatesAt, tokenID, m.cfg.FeeRateTTL) {
+		return 0, false
+	}
+	return m.feeRates[tokenID], true
+}
+
+func (m *metadataCache) setFeeRate(tokenID string, feeRate int) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.feeRates[tokenID] = feeRate
+	m.feeRatesAt[tokenID] = m.now()
+}
+
+func (m *metadataCache) market(tokenID string) (*types.Market, bool) {
+	if m == nil {
+		return nil, false
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if !m.fresh(m.marketsAt, tokenID, m.cfg.MarketTTL) {
+		return nil, false
+	}
+	return m.markets[tokenID], true
+}
+
+func (m *metadataCache) setMarket(tokenID string, market *types.Market) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.markets[tokenID] = market
+	m.marketsAt[tokenID] = m.now()
+}
+
+func (m *metadataCache) invalidate(tokenIDs ...string) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(tokenIDs) == 0 {
+		m.tickSizes, m.tickSizesAt = make(types.TickSizes), make(map[string]time.Time)
+		m.negRisk, m.negRiskAt = make(types.NegRisk), make(map[string]time.Time)
+		m.feeRates, m.feeRatesAt = make(types.FeeRates), make(map[string]time.Time)
+		m.markets, m.marketsAt = make(map[string]*types.Market), make(map[string]time.Time)
+		return
+	}
+	for _, id := range tokenIDs {
+		delete(m.tickSizes, id)
+		delete(m.tickSizesAt, id)
+		delete(m.negRisk, id)
+		delete(m.negRiskAt, id)
+		delete(m.feeRates, id)
+		delete(m.feeRatesAt, id)
+		delete(m.markets, id)
+		delete(m.marketsAt, id)
+	}
+}
+
+// InvalidateMetadata drops the cached metadata of tokenIDs, or of every token when none is given.
+func (c *ClobClient) InvalidateMetadata(tokenIDs ...string) {
+	c.metadata.invalidate(tokenIDs...)
+}
+
+// SetTickSize overrides the cached tick size of tokenID, e.g. after a tick_size_change
+// websocket message. It is a no-op when the metadata cache is disabled.
+func (c *ClobClient) SetTickSize(tokenID string, tickSize types.TickSize) {
+	c.metadata.setTickSize(tokenID, tickSize)
+}
+
+// WarmMetadata loads the tick size, neg-risk flag and fee rate of tokenIDs into the cache.
+func (c *ClobClient) WarmMetadata(tokenIDs []string) error {
+	return c.WarmMetadataWithContext(context.Background(), tokenIDs)
+}
+
+func (c *ClobClient) WarmMetadataWithContext(ctx context.Context, tokenIDs []string) error {
+	if c.metadata == nil {
+		return errors.New("metadata cache is disabled")
+	}
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	sem := make(chan struct{}, batchConcurrency)
+	for _, tokenID := range tokenIDs {
+		wg.Add(1)
+		go func(tokenID string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			var err error
+			if _, err = c.GetTickSizeWithContext(ctx, tokenID); err == nil {
+				if _, err = c.GetNegRiskWithContext(ctx, tokenID); err == nil {
+					_, err = c.GetFeeRateBpsWithContext(ctx, tokenID)
+				}
+			}
+			if err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}(tokenID)
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
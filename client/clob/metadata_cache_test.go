@@ -0,0 +1,78 @@
+package clob
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ybina/polymarket-go/client/types"
+)
+
+func TestClobClient_MetadataCache(t *testing.T) {
+	var tickCalls, negRiskCalls, feeCalls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/tick-size":
+			tickCalls.Add(1)
+			_, _ = w.Write([]byte(`{"minimum_tick_size":0.01}`))
+		case "/neg-risk":
+			negRiskCalls.Add(1)
+			_, _ = w.Write([]byte(`{"neg_risk":true}`))
+		case "/fee-rate":
+			feeCalls.Add(1)
+			_, _ = w.Write([]byte(`{"base_fee":0}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	clobClient, err := NewClobClient(&ClientConfig{
+		Host:          srv.URL,
+		ChainID:       types.ChainPolygon,
+		MetadataCache: &MetadataCacheConfig{TickSizeTTL: time.Minute},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	clobClient.metadata.now = func() time.Time { return now }
+
+	if err := clobClient.WarmMetadata([]string{"1", "2"}); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if tickSize, err := clobClient.GetTickSize("1"); err != nil || tickSize != types.TickSize001 {
+			t.Fatalf("tick size: %v %v", tickSize, err)
+		}
+		if negRisk, err := clobClient.GetNegRisk("2"); err != nil || !negRisk {
+			t.Fatalf("neg risk: %v %v", negRisk, err)
+		}
+	}
+	if tickCalls.Load() != 2 || negRiskCalls.Load() != 2 || feeCalls.Load() != 2 {
+		t.Fatalf("expected one call per token, got %d %d %d", tickCalls.Load(), negRiskCalls.Load(), feeCalls.Load())
+	}
+
+	clobClient.SetTickSize("1", types.TickSize0001)
+	if tickSize, _ := clobClient.GetTickSize("1"); tickSize != types.TickSize0001 {
+		t.Fatalf("expected updated tick size, got %v", tickSize)
+	}
+
+	now = now.Add(2 * time.Minute)
+	if _, err := clobClient.GetTickSize("1"); err != nil {
+		t.Fatal(err)
+	}
+	if tickCalls.Load() != 3 {
+		t.Fatalf("expected refetch after ttl, got %d calls", tickCalls.Load())
+	}
+
+	clobClient.InvalidateMetadata("2")
+	if _, err := clobClient.GetNegRisk("2"); err != nil {
+		t.Fatal(err)
+	}
+	if negRiskCalls.Load() != 3 {
+		t.Fatalf("expected refetch after invalidation, got %d calls", negRiskCalls.Load())
+	}
+}
@@ -0,0 +1,43 @@
+package clob
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ybina/polymarket-go/client/endpoint"
+	"github.com/ybina/polymarket-go/client/types"
+)
+
+// GetNotifications returns the pending notifications of the funder. Use
+// Notification.OrderPayload or Notification.MarketResolvedPayload to decode them.
+func (c *ClobClient) GetNotifications(signerAddr common.Address) ([]types.Notification, error) {
+	return c.GetNotificationsWithContext(context.Background(), signerAddr)
+}
+
+func (c *ClobClient) GetNotificationsWithContext(ctx context.Context, signerAddr common.Address) ([]types.Notification, error) {
+	params := url.Values{}
+	params.Add("signature_type", strconv.Itoa(int(c.signatureType())))
+	var result []types.Notification
+	if err := c.l2Get(ctx, endpoint.GetNotifications, signerAddr, params, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DropNotifications marks the given notifications as read.
+func (c *ClobClient) DropNotifications(params types.DropNotificationParams, signerAddr common.Address) error {
+	return c.DropNotificationsWithContext(context.Background(), params, signerAddr)
+}
+
+func (c *ClobClient) DropNotificationsWithContext(ctx context.Context, params types.DropNotificationParams, signerAddr common.Address) error {
+	if len(params.IDs) == 0 {
+		return fmt.Errorf("no notification ids to drop")
+	}
+	queryParams := url.Values{}
+	queryParams.Add("ids", strings.Join(params.IDs, ","))
+	return c.sendWithL2(ctx, "DELETE", endpoint.DropNotifications, queryParams, nil, signerAddr, false, nil)
+}
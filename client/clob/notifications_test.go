@@ -0,0 +1,63 @@
+package clob
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ybina/polymarket-go/client/types"
+)
+
+func TestClobClient_Notifications(t *testing.T) {
+	var dropped, geoBlockToken string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/time":
+			_, _ = w.Write([]byte("1700000000"))
+		case r.URL.Path == "/notifications" && r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(`[
+				{"id":1,"type":2,"owner":"test-key","payload":{"order_id":"0x1","asset_id":"1","side":"BUY","price":"0.5","matched_size":"10"}},
+				{"id":2,"type":4,"owner":"test-key","payload":{"condition_id":"0xabc","winning_outcome":"Yes"}}
+			]`))
+		case r.URL.Path == "/notifications" && r.Method == http.MethodDelete:
+			if r.Header.Get("POLY_API_KEY") != "test-key" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			dropped = r.URL.Query().Get("ids")
+			geoBlockToken = r.URL.Query().Get("geo_block_token")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	clobClient := newTestPrivateKeyClient(t, srv.URL)
+	clobClient.geoBlockToken = "geo"
+	notifications, err := clobClient.GetNotifications(common.Address{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(notifications) != 2 {
+		t.Fatalf("unexpected notifications: %+v", notifications)
+	}
+	fill, err := notifications[0].OrderPayload()
+	if err != nil || fill.OrderID != "0x1" || fill.MatchedSize != "10" {
+		t.Fatalf("order payload: %+v %v", fill, err)
+	}
+	if _, err := notifications[0].MarketResolvedPayload(); err == nil {
+		t.Fatal("expected error decoding fill as market resolved")
+	}
+	resolved, err := notifications[1].MarketResolvedPayload()
+	if err != nil || resolved.WinningOutcome != "Yes" {
+		t.Fatalf("market resolved payload: %+v %v", resolved, err)
+	}
+
+	if err := clobClient.DropNotifications(types.DropNotificationParams{IDs: []string{"1", "2"}}, common.Address{}); err != nil {
+		t.Fatal(err)
+	}
+	if dropped != "1,2" || geoBlockToken != "geo" {
+		t.Fatalf("unexpected dropped ids %q or geo block token %q", dropped, geoBlockToken)
+	}
+}
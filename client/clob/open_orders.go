@@ -0,0 +1,69 @@
+package clob
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ybina/polymarket-go/client/constants"
+	"github.com/ybina/polymarket-go/client/endpoint"
+	"github.com/ybina/polymarket-go/client/types"
+)
+
+// GetOpenOrders returns every open order of the API key matching params, following
+// next_cursor until the last page. params may be nil. Requests are signed for the private
+// key of the client; Turnkey clients pass their account to IterOpenOrders.
+func (c *ClobClient) GetOpenOrders(params *types.OpenOrderParams) ([]types.OpenOrder, error) {
+	return c.GetOpenOrdersWithContext(context.Background(), params)
+}
+
+func (c *ClobClient) GetOpenOrdersWithContext(ctx context.Context, params *types.OpenOrderParams) ([]types.OpenOrder, error) {
+	return c.IterOpenOrders(ctx, constants.ZERO_ADDRESS, params).collect()
+}
+
+// GetOrders returns the open orders with the given IDs. IDs without an open order are
+// skipped.
+//
+// Deprecated: use GetOpenOrders, filtering by ID.
+func (c *ClobClient) GetOrders(orderIds []string) ([]types.OpenOrder, error) {
+	if len(orderIds) == 0 {
+		return nil, nil
+	}
+	wanted := make(map[string]bool, len(orderIds))
+	for _, id := range orderIds {
+		wanted[id] = true
+	}
+	var orders []types.OpenOrder
+	it := c.IterOpenOrders(context.Background(), constants.ZERO_ADDRESS, nil)
+	for it.Next() {
+		if order := it.Value(); wanted[order.ID] {
+			orders = append(orders, order)
+		}
+	}
+	return orders, it.Err()
+}
+
+// IterOpenOrders returns an iterator over the open orders matching params that fetches one page at a time.
+// funder is the address of the API key; the zero address uses the private key of the client.
+func (c *ClobClient) IterOpenOrders(ctx context.Context, funder common.Address, params *types.OpenOrderParams) *Iterator[types.OpenOrder] {
+	return newIterator(ctx, types.INITIAL_CURSOR, func(ctx context.Context, cursor string) ([]types.OpenOrder, string, error) {
+		return c.GetOpenOrdersPage(ctx, funder, params, cursor)
+	})
+}
+
+// GetOpenOrdersPage fetches a single page of open orders and returns it with the next cursor.
+func (c *ClobClient) GetOpenOrdersPage(ctx context.Context, funder common.Address, params *types.OpenOrderParams, nextCursor string) ([]types.OpenOrder, string, error) {
+	queryParams := url.Values{}
+	if params != nil {
+		if params.ID != nil {
+			queryParams.Add("id", *params.ID)
+		}
+		if params.Market != nil {
+			queryParams.Add("market", *params.Market)
+		}
+		if params.AssetID != nil {
+			queryParams.Add("asset_id", *params.AssetID)
+		}
+	}
+	return fetchL2Page[types.OpenOrder](ctx, c, endpoint.GetOpenOrders, funder, queryParams, nextCursor)
+}
@@ -0,0 +1,68 @@
+package clob
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ybina/polymarket-go/client/types"
+)
+
+func TestClobClient_GetOpenOrders(t *testing.T) {
+	var cursors []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/data/orders" || r.Header.Get("POLY_API_KEY") != "test-key" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.URL.Query().Get("market") != "0xabc" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		cursor := r.URL.Query().Get("next_cursor")
+		cursors = append(cursors, cursor)
+		switch cursor {
+		case types.INITIAL_CURSOR:
+			_, _ = w.Write([]byte(`{"data":[{"id":"0x1","market":"0xabc"},{"id":"0x2","market":"0xabc"}],"next_cursor":"Mg=="}`))
+		case "Mg==":
+			_, _ = w.Write([]byte(`{"data":[{"id":"0x3","market":"0xabc"}],"next_cursor":"LTE="}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+
+	clobClient := newTestPrivateKeyClient(t, srv.URL)
+	market := "0xabc"
+	orders, err := clobClient.GetOpenOrders(&types.OpenOrderParams{Market: &market})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orders) != 3 || orders[2].ID != "0x3" {
+		t.Fatalf("unexpected orders: %+v", orders)
+	}
+	if len(cursors) != 2 {
+		t.Fatalf("expected 2 pages, got %v", cursors)
+	}
+}
+
+func TestClobClient_GetOrders(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("next_cursor") {
+		case types.INITIAL_CURSOR:
+			_, _ = w.Write([]byte(`{"data":[{"id":"0x1"},{"id":"0x2"}],"next_cursor":"Mg=="}`))
+		default:
+			_, _ = w.Write([]byte(`{"data":[{"id":"0x3"}],"next_cursor":"LTE="}`))
+		}
+	}))
+	defer srv.Close()
+
+	clobClient := newTestPrivateKeyClient(t, srv.URL)
+	orders, err := clobClient.GetOrders([]string{"0x3", "0x1", "0x9"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orders) != 2 || orders[0].ID != "0x1" || orders[1].ID != "0x3" {
+		t.Fatalf("unexpected orders: %+v", orders)
+	}
+}
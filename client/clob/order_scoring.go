@@ -0,0 +1,45 @@
+package clob
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ybina/polymarket-go/client/endpoint"
+	"github.com/ybina/polymarket-go/client/types"
+)
+
+// IsOrderScoring reports whether a resting order currently earns liquidity rewards.
+func (c *ClobClient) IsOrderScoring(orderID string, signerAddr common.Address) (bool, error) {
+	return c.IsOrderScoringWithContext(context.Background(), orderID, signerAddr)
+}
+
+func (c *ClobClient) IsOrderScoringWithContext(ctx context.Context, orderID string, signerAddr common.Address) (bool, error) {
+	if orderID == "" {
+		return false, fmt.Errorf("order id is required")
+	}
+	params := url.Values{}
+	params.Add("order_id", orderID)
+	var result types.OrderScoring
+	if err := c.l2Get(ctx, endpoint.IsOrderScoring, signerAddr, params, &result); err != nil {
+		return false, err
+	}
+	return result.Scoring, nil
+}
+
+// AreOrdersScoring reports for each order id whether the order earns liquidity rewards.
+func (c *ClobClient) AreOrdersScoring(orderIDs []string, signerAddr common.Address) (types.OrdersScoring, error) {
+	return c.AreOrdersScoringWithContext(context.Background(), orderIDs, signerAddr)
+}
+
+func (c *ClobClient) AreOrdersScoringWithContext(ctx context.Context, orderIDs []string, signerAddr common.Address) (types.OrdersScoring, error) {
+	if len(orderIDs) == 0 {
+		return types.OrdersScoring{}, nil
+	}
+	result := make(types.OrdersScoring, len(orderIDs))
+	if err := c.postWithL2(ctx, endpoint.AreOrdersScoring, orderIDs, signerAddr, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
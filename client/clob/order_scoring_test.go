@@ -0,0 +1,52 @@
+package clob
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestClobClient_OrderScoring(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/time" && r.Header.Get("POLY_API_KEY") != "test-key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/time":
+			_, _ = w.Write([]byte("1700000000"))
+		case "/order-scoring":
+			if r.URL.Query().Get("order_id") == "0x1" {
+				_, _ = w.Write([]byte(`{"scoring":true}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"scoring":false}`))
+		case "/orders-scoring":
+			body, _ := io.ReadAll(r.Body)
+			if r.Method != http.MethodPost || string(body) != `["0x1","0x2"]` {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			_, _ = w.Write([]byte(`{"0x1":true,"0x2":false}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	clobClient := newTestPrivateKeyClient(t, srv.URL)
+	scoring, err := clobClient.IsOrderScoring("0x1", common.Address{})
+	if err != nil || !scoring {
+		t.Fatalf("is order scoring: %v %v", scoring, err)
+	}
+	result, err := clobClient.AreOrdersScoring([]string{"0x1", "0x2"}, common.Address{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result["0x1"] || result["0x2"] {
+		t.Fatalf("unexpected scoring: %v", result)
+	}
+}
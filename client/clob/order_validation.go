@@ -0,0 +1,457 @@
+package clob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/ybina/polymarket-go/client/clob/clob_types"
+	"github.com/ybina/polymarket-go/client/constants"
+	"github.com/ybina/polymarket-go/client/types"
+)
+
+// ViolationCode identifies a rule an order breaks.
+type ViolationCode string
+
+const (
+	ViolationInvalidSide           ViolationCode = "invalid_side"
+	ViolationInvalidOrderType      ViolationCode = "invalid_order_type"
+	ViolationInvalidTickSize       ViolationCode = "invalid_tick_size"
+	ViolationPriceOutOfRange       ViolationCode = "price_out_of_range"
+	ViolationPriceNotOnTick        ViolationCode = "price_not_on_tick"
+	ViolationSizeNotPositive       ViolationCode = "size_not_positive"
+	ViolationSizePrecision         ViolationCode = "size_precision"
+	ViolationAmountPrecision       ViolationCode = "amount_precision"
+	ViolationBelowMinSize          ViolationCode = "below_min_size"
+	ViolationExpirationRequired    ViolationCode = "expiration_required"
+	ViolationExpirationNotAllowed  ViolationCode = "expiration_not_allowed"
+	ViolationExpirationTooSoon     ViolationCode = "expiration_too_soon"
+	ViolationTakerNotAllowed       ViolationCode = "taker_not_allowed"
+	ViolationPostOnlyOrderType     ViolationCode = "post_only_order_type"
+	ViolationPostOnlyCrosses       ViolationCode = "post_only_crosses"
+	ViolationInsufficientLiquidity ViolationCode = "insufficient_liquidity"
+	ViolationUnknownToken          ViolationCode = "unknown_token"
+	ViolationMarketClosed          ViolationCode = "market_closed"
+	ViolationMarketInactive        ViolationCode = "market_inactive"
+	ViolationNotAcceptingOrders    ViolationCode = "not_accepting_orders"
+)
+
+// Violation is one rule an order breaks. Field names the order argument at fault.
+type Violation struct {
+	Code    ViolationCode `json:"code"`
+	Field   string        `json:"field,omitempty"`
+	Message string        `json:"message"`
+}
+
+func (v Violation) Error() string {
+	return v.Message
+}
+
+// ValidationError is returned when an order is rejected before signing.
+type ValidationError struct {
+	Violations []Violation
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		msgs[i] = v.Message
+	}
+	return "invalid order: " + strings.Join(msgs, "; ")
+}
+
+// Has reports whether the error contains a violation with the given code.
+func (e *ValidationError) Has(code ViolationCode) bool {
+	for _, v := range e.Violations {
+		if v.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderValidationContext is the market information an order is validated against.
+// Only TickSize is required; the checks depending on the other fields are skipped
+// when they are unset.
+type OrderValidationContext struct {
+	TickSize types.TickSize
+	// MinOrderSize is the smallest size in shares; defaults to the size of Book.
+	MinOrderSize decimal.Decimal
+	// Market enables the token and market status checks.
+	Market *types.Market
+	// Book enables the liquidity check of FOK orders.
+	Book *types.OrderBookSummary
+	// Now is the time expirations are compared to; zero uses the current time.
+	Now time.Time
+}
+
+// ValidateOrder checks a limit order against the exchange rules without any request,
+// so that it can be used to validate an order form. Of the options, only the order type
+// and the post-only flag are read. It returns every violation found.
+func ValidateOrder(args clob_types.OrderArgs, option clob_types.PartialCreateOrderOptions, vctx OrderValidationContext) []Violation {
+	var vs violations
+	orderType := option.OrderType
+	if orderType == "" {
+		orderType = types.OrderTypeGTC
+	}
+	vs.checkSide(args.Side)
+	vs.checkOrderType(orderType)
+	tick, round, ok := vs.checkTickSize(vctx.TickSize)
+	if ok {
+		vs.checkPrice(args.Price, tick)
+	}
+
+	if !args.Size.IsPositive() {
+		vs.add(ViolationSizeNotPositive, "size", "size (%s) must be positive", args.Size)
+	} else if ok && !hasMaxDecimals(args.Size, round.Size) {
+		vs.add(ViolationSizePrecision, "size", "size (%s) supports at most %d decimals", args.Size, round.Size)
+	}
+	if minSize := vctx.minOrderSize(); args.Size.IsPositive() && args.Size.LessThan(minSize) {
+		vs.add(ViolationBelowMinSize, "size", "size (%s) is below the minimum order size %s", args.Size, minSize)
+	}
+
+	// FOK and FAK orders are matched as market orders, whose amounts have a stricter precision
+	if ok && (orderType == types.OrderTypeFOK || orderType == types.OrderTypeFAK) && args.Price.IsPositive() {
+		notional := args.Size.Mul(args.Price)
+		makerAmount, takerAmount := notional, args.Size
+		if args.Side == types.SideSell {
+			makerAmount, takerAmount = args.Size, notional
+		}
+		if !hasMaxDecimals(makerAmount, 2) || !hasMaxDecimals(takerAmount, 4) {
+			vs.add(ViolationAmountPrecision, "size", "%s orders support at most 2 decimals for the maker amount (%s) and 4 for the taker amount (%s)", orderType, makerAmount, takerAmount)
+		}
+	}
+	if orderType == types.OrderTypeFOK && vctx.Book != nil && args.Size.IsPositive() {
+		if available, err := fillableSize(vctx.Book, args.Side, args.Price); err == nil && available.LessThan(args.Size) {
+			vs.add(ViolationInsufficientLiquidity, "size", "the book fills %s of %s shares at %s", available, args.Size, args.Price)
+		}
+	}
+
+	if option.PostOnly && vs.checkPostOnly(orderType) {
+		if best, ok := bestOpposite(vctx.Book, args.Side); ok && crosses(args.Side, args.Price, best) {
+			vs.add(ViolationPostOnlyCrosses, "price", "post-only order at %s would match the best %s price %s", args.Price, oppositeSide(args.Side), best)
+		}
+	}
+
+	vs.checkExpiration(int64(args.Expiration), orderType, vctx.now())
+	vs.checkTaker(args.Taker.Hex())
+	vs.checkMarket(args.TokenID, vctx.Market)
+	return vs
+}
+
+// ValidateMarketOrder checks a market order like ValidateOrder. The price is the worst
+// accepted price; when it is zero the price checks are skipped since it is computed
+// from the book when the order is created.
+func ValidateMarketOrder(args clob_types.MarketOrderArgs, option clob_types.PartialCreateOrderOptions, vctx OrderValidationContext) []Violation {
+	var vs violations
+	orderType := args.OrderType
+	if orderType == "" {
+		orderType = option.OrderType
+	}
+	if orderType == "" {
+		orderType = types.OrderTypeFOK
+	}
+	vs.checkSide(args.Side)
+	if orderType != types.OrderTypeFOK && orderType != types.OrderTypeFAK {
+		vs.add(ViolationInvalidOrderType, "order_type", "market orders must be FOK or FAK, got %q", orderType)
+	}
+	tick, _, ok := vs.checkTickSize(vctx.TickSize)
+	if ok && !args.Price.IsZero() {
+		vs.checkPrice(args.Price, tick)
+	}
+
+	if !args.Amount.IsPositive() {
+		vs.add(ViolationSizeNotPositive, "amount", "amount (%s) must be positive", args.Amount)
+	} else if minSize := vctx.minOrderSize(); minSize.IsPositive() {
+		// buy amounts are in collateral, sell amounts in shares
+		shares := args.Amount
+		if args.Side == types.SideBuy {
+			shares = decimal.Zero
+			if args.Price.IsPositive() {
+				shares = args.Amount.Div(args.Price)
+			}
+		}
+		if !shares.IsZero() && shares.LessThan(minSize) {
+			vs.add(ViolationBelowMinSize, "amount", "order size (%s shares) is below the minimum order size %s", shares.StringFixed(2), minSize)
+		}
+	}
+	if vctx.Book != nil && args.Amount.IsPositive() && orderType == types.OrderTypeFOK {
+		if _, _, err := marketPriceFromBook(vctx.Book, args.Side, args.Amount, orderType); errors.Is(err, ErrInsufficientLiquidity) {
+			vs.add(ViolationInsufficientLiquidity, "amount", "the book cannot fill an amount of %s", args.Amount)
+		}
+	}
+
+	if option.PostOnly {
+		vs.checkPostOnly(orderType)
+	}
+
+	vs.checkTaker(args.Taker.Hex())
+	vs.checkMarket(args.TokenID, vctx.Market)
+	return vs
+}
+
+// ValidateOrder validates a limit order against the current market: its tick size,
+// minimum order size, status and order book. It returns the violations found, and an
+// error only when the market information could not be fetched.
+func (c *ClobClient) ValidateOrder(args clob_types.OrderArgs, option clob_types.PartialCreateOrderOptions) ([]Violation, error) {
+	return c.ValidateOrderWithContext(context.Background(), args, option)
+}
+
+func (c *ClobClient) ValidateOrderWithContext(ctx context.Context, args clob_types.OrderArgs, option clob_types.PartialCreateOrderOptions) ([]Violation, error) {
+	vctx, err := c.orderValidationContext(ctx, args.TokenID, option)
+	if err != nil {
+		return nil, err
+	}
+	return ValidateOrder(args, option, *vctx), nil
+}
+
+// ValidateMarketOrder validates a market order against the current market.
+func (c *ClobClient) ValidateMarketOrder(args clob_types.MarketOrderArgs, option clob_types.PartialCreateOrderOptions) ([]Violation, error) {
+	return c.ValidateMarketOrderWithContext(context.Background(), args, option)
+}
+
+func (c *ClobClient) ValidateMarketOrderWithContext(ctx context.Context, args clob_types.MarketOrderArgs, option clob_types.PartialCreateOrderOptions) ([]Violation, error) {
+	vctx, err := c.orderValidationContext(ctx, args.TokenID, option)
+	if err != nil {
+		return nil, err
+	}
+	return ValidateMarketOrder(args, option, *vctx), nil
+}
+
+func (c *ClobClient) orderValidationContext(ctx context.Context, tokenID string, option clob_types.PartialCreateOrderOptions) (*OrderValidationContext, error) {
+	book, err := c.GetOrderBookWithContext(ctx, tokenID)
+	if err != nil {
+		return nil, err
+	}
+	vctx := &OrderValidationContext{Book: book, Now: c.validationNow()}
+	if option.TickSize != nil {
+		vctx.TickSize = *option.TickSize
+	} else if vctx.TickSize, err = c.GetTickSizeWithContext(ctx, tokenID); err != nil {
+		return nil, err
+	}
+	if book.Market != "" {
+		if vctx.Market, err = c.GetMarketWithContext(ctx, book.Market); err != nil {
+			return nil, err
+		}
+		c.metadata.setMarket(tokenID, vctx.Market)
+	}
+	return vctx, nil
+}
+
+// preflightContext is the context orders are checked against when they are created. It
+// sends no request: the market is only set when the metadata cache holds it.
+func (c *ClobClient) preflightContext(tokenID string, tickSize types.TickSize) OrderValidationContext {
+	vctx := OrderValidationContext{TickSize: tickSize, Now: c.validationNow()}
+	if market, ok := c.metadata.market(tokenID); ok {
+		vctx.Market = market
+	}
+	return vctx
+}
+
+// preflightError returns the violations of an order being created as an error. The order
+// builder rounds prices and sizes to the tick size, so the precision rules are left to
+// the explicit ValidateOrder API.
+func preflightError(vs []Violation) error {
+	var kept []Violation
+	for _, v := range vs {
+		switch v.Code {
+		case ViolationPriceNotOnTick, ViolationSizePrecision, ViolationAmountPrecision:
+			continue
+		}
+		kept = append(kept, v)
+	}
+	return violationsError(kept)
+}
+
+// violationsError returns a *ValidationError when there are violations, nil otherwise.
+func violationsError(violations []Violation) error {
+	if len(violations) == 0 {
+		return nil
+	}
+	return &ValidationError{Violations: violations}
+}
+
+type violations []Violation
+
+func (vs *violations) add(code ViolationCode, field, format string, args ...interface{}) {
+	*vs = append(*vs, Violation{Code: code, Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+func (vs *violations) checkSide(side types.Side) {
+	if side != types.SideBuy && side != types.SideSell {
+		vs.add(ViolationInvalidSide, "side", "side must be %s or %s, got %q", types.SideBuy, types.SideSell, side)
+	}
+}
+
+func (vs *violations) checkOrderType(orderType types.OrderType) {
+	switch orderType {
+	case types.OrderTypeGTC, types.OrderTypeGTD, types.OrderTypeFOK, types.OrderTypeFAK:
+	default:
+		vs.add(ViolationInvalidOrderType, "order_type", "unknown order type %q", orderType)
+	}
+}
+
+func (vs *violations) checkTickSize(tickSize types.TickSize) (decimal.Decimal, *types.RoundConfig, bool) {
+	round := types.GetRoundConfig(tickSize)
+	if round == nil {
+		vs.add(ViolationInvalidTickSize, "tick_size", "unsupported tick size %q", tickSize)
+		return decimal.Decimal{}, nil, false
+	}
+	return decimal.RequireFromString(string(tickSize)), round, true
+}
+
+func (vs *violations) checkPrice(price, tick decimal.Decimal) {
+	maxPrice := decimal.NewFromInt(1).Sub(tick)
+	if price.LessThan(tick) || price.GreaterThan(maxPrice) {
+		vs.add(ViolationPriceOutOfRange, "price", "price (%s) must be between %s and %s", price, tick, maxPrice)
+		return
+	}
+	if !price.Mod(tick).IsZero() {
+		vs.add(ViolationPriceNotOnTick, "price", "price (%s) is not a multiple of the tick size %s", price, tick)
+	}
+}
+
+func (vs *violations) checkPostOnly(orderType types.OrderType) bool {
+	if orderType != types.OrderTypeGTC && orderType != types.OrderTypeGTD {
+		vs.add(ViolationPostOnlyOrderType, "post_only", "post-only orders must be %s or %s, got %s", types.OrderTypeGTC, types.OrderTypeGTD, orderType)
+		return false
+	}
+	return true
+}
+
+func (vs *violations) checkExpiration(expiration int64, orderType types.OrderType, now time.Time) {
+	if orderType != types.OrderTypeGTD {
+		if expiration != 0 {
+			vs.add(ViolationExpirationNotAllowed, "expiration", "only GTD orders can expire, %s orders must have no expiration", orderType)
+		}
+		return
+	}
+	if expiration == 0 {
+		vs.add(ViolationExpirationRequired, "expiration", "GTD orders require an expiration")
+		return
+	}
+	// the security threshold comes on top of the lifetime of the order
+	if earliest := now.Add(MinGTDExpiration); !time.Unix(expiration, 0).After(earliest) {
+		vs.add(ViolationExpirationTooSoon, "expiration", "expiration (%d) must be more than %s after now (%d)", expiration, MinGTDExpiration, now.Unix())
+	}
+}
+
+func (vs *violations) checkTaker(taker string) {
+	if taker != constants.ZERO_ADDRESS.Hex() {
+		vs.add(ViolationTakerNotAllowed, "taker", "orders must be public, taker must be the zero address, got %s", taker)
+	}
+}
+
+func (vs *violations) checkMarket(tokenID string, market *types.Market) {
+	if market == nil {
+		return
+	}
+	found := false
+	for _, t := range market.Tokens {
+		if t.TokenID == tokenID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		vs.add(ViolationUnknownToken, "token_id", "token %s is not an outcome of market %s", tokenID, market.ConditionID)
+	}
+	switch {
+	case market.Closed:
+		vs.add(ViolationMarketClosed, "token_id", "market %s is closed", market.ConditionID)
+	case !market.Active:
+		vs.add(ViolationMarketInactive, "token_id", "market %s is not active", market.ConditionID)
+	case !market.AcceptingOrders:
+		vs.add(ViolationNotAcceptingOrders, "token_id", "market %s is not accepting orders", market.ConditionID)
+	}
+}
+
+func (vctx OrderValidationContext) minOrderSize() decimal.Decimal {
+	if vctx.MinOrderSize.IsPositive() {
+		return vctx.MinOrderSize
+	}
+	if vctx.Book != nil && vctx.Book.MinOrderSize != "" {
+		if size, err := decimal.NewFromString(vctx.Book.MinOrderSize); err == nil {
+			return size
+		}
+	}
+	if vctx.Market != nil {
+		return vctx.Market.MinimumOrderSize
+	}
+	return decimal.Zero
+}
+
+func (vctx OrderValidationContext) now() time.Time {
+	if vctx.Now.IsZero() {
+		return time.Now()
+	}
+	return vctx.Now
+}
+
+// bestOpposite returns the best price of the side of the book an order would match against.
+func bestOpposite(book *types.OrderBookSummary, side types.Side) (decimal.Decimal, bool) {
+	if book == nil {
+		return decimal.Decimal{}, false
+	}
+	levels := book.Asks
+	if side == types.SideSell {
+		levels = book.Bids
+	}
+	var best decimal.Decimal
+	found := false
+	for _, l := range levels {
+		p, err := decimal.NewFromString(l.Price)
+		if err != nil {
+			continue
+		}
+		if !found || (side == types.SideBuy && p.LessThan(best)) || (side == types.SideSell && p.GreaterThan(best)) {
+			best, found = p, true
+		}
+	}
+	return best, found
+}
+
+func oppositeSide(side types.Side) types.Side {
+	if side == types.SideBuy {
+		return types.SideSell
+	}
+	return types.SideBuy
+}
+
+// fillableSize returns the shares of the opposite side of the book a limit order at price can match.
+func fillableSize(book *types.OrderBookSummary, side types.Side, price decimal.Decimal) (decimal.Decimal, error) {
+	levels := book.Asks
+	if side == types.SideSell {
+		levels = book.Bids
+	}
+	sum := decimal.Zero
+	for _, l := range levels {
+		p, err := decimal.NewFromString(l.Price)
+		if err != nil {
+			return decimal.Decimal{}, fmt.Errorf("invalid book price %q: %w", l.Price, err)
+		}
+		s, err := decimal.NewFromString(l.Size)
+		if err != nil {
+			return decimal.Decimal{}, fmt.Errorf("invalid book size %q: %w", l.Size, err)
+		}
+		if crosses(side, price, p) {
+			sum = sum.Add(s)
+		}
+	}
+	return sum, nil
+}
+
+// crosses reports whether a limit order at limit matches an opposite order at price.
+func crosses(side types.Side, limit, price decimal.Decimal) bool {
+	if side == types.SideBuy {
+		return price.LessThanOrEqual(limit)
+	}
+	return price.GreaterThanOrEqual(limit)
+}
+
+func hasMaxDecimals(d decimal.Decimal, places int) bool {
+	return d.Truncate(int32(places)).Equal(d)
+}
@@ -0,0 +1,201 @@
+package clob
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/shopspring/decimal"
+	"github.com/ybina/polymarket-go/client/clob/clob_types"
+	"github.com/ybina/polymarket-go/client/types"
+	"github.com/ybina/polymarket-go/tools/headers"
+)
+
+func violationCodes(vs []Violation) []ViolationCode {
+	codes := make([]ViolationCode, len(vs))
+	for i, v := range vs {
+		codes[i] = v.Code
+	}
+	return codes
+}
+
+func TestValidateOrder(t *testing.T) {
+	d := decimal.RequireFromString
+	now := time.Unix(1700000000, 0)
+	vctx := OrderValidationContext{
+		TickSize:     types.TickSize001,
+		MinOrderSize: d("5"),
+		Now:          now,
+		Book: &types.OrderBookSummary{
+			Asks: []types.OrderSummary{{Price: "0.55", Size: "10"}, {Price: "0.50", Size: "10"}},
+			Bids: []types.OrderSummary{{Price: "0.45", Size: "10"}},
+		},
+		Market: &types.Market{
+			ConditionID:     "0xc",
+			Active:          true,
+			AcceptingOrders: true,
+			Tokens:          []types.MarketToken{{TokenID: "1"}, {TokenID: "2"}},
+		},
+	}
+	valid := clob_types.OrderArgs{TokenID: "1", Price: d("0.5"), Size: d("10"), Side: types.SideBuy}
+
+	tests := []struct {
+		name      string
+		modify    func(a *clob_types.OrderArgs)
+		orderType types.OrderType
+		postOnly  bool
+		want      []ViolationCode
+	}{
+		{"valid", func(a *clob_types.OrderArgs) {}, types.OrderTypeGTC, false, nil},
+		{"invalid side", func(a *clob_types.OrderArgs) { a.Side = "HOLD" }, types.OrderTypeGTC, false, []ViolationCode{ViolationInvalidSide}},
+		{"price out of range", func(a *clob_types.OrderArgs) { a.Price = d("0.995") }, types.OrderTypeGTC, false, []ViolationCode{ViolationPriceOutOfRange}},
+		{"price off tick", func(a *clob_types.OrderArgs) { a.Price = d("0.505") }, types.OrderTypeGTC, false, []ViolationCode{ViolationPriceNotOnTick}},
+		{"zero size", func(a *clob_types.OrderArgs) { a.Size = decimal.Zero }, types.OrderTypeGTC, false, []ViolationCode{ViolationSizeNotPositive}},
+		{"size precision", func(a *clob_types.OrderArgs) { a.Size = d("10.001") }, types.OrderTypeGTC, false, []ViolationCode{ViolationSizePrecision}},
+		{"below min size", func(a *clob_types.OrderArgs) { a.Size = d("4.99") }, types.OrderTypeGTC, false, []ViolationCode{ViolationBelowMinSize}},
+		{"GTD without expiration", func(a *clob_types.OrderArgs) {}, types.OrderTypeGTD, false, []ViolationCode{ViolationExpirationRequired}},
+		{"GTD expiring too soon", func(a *clob_types.OrderArgs) { a.Expiration = int(now.Unix()) + 30 }, types.OrderTypeGTD, false, []ViolationCode{ViolationExpirationTooSoon}},
+		{"GTD expiring at the threshold", func(a *clob_types.OrderArgs) { a.Expiration = int(now.Unix()) + 60 }, types.OrderTypeGTD, false, []ViolationCode{ViolationExpirationTooSoon}},
+		{"GTD", func(a *clob_types.OrderArgs) { a.Expiration = int(now.Unix()) + 3600 }, types.OrderTypeGTD, false, nil},
+		{"GTC with expiration", func(a *clob_types.OrderArgs) { a.Expiration = int(now.Unix()) + 3600 }, types.OrderTypeGTC, false, []ViolationCode{ViolationExpirationNotAllowed}},
+		{"FAK amount precision", func(a *clob_types.OrderArgs) { a.Size, a.Price = d("5.55"), d("0.51") }, types.OrderTypeFAK, false, []ViolationCode{ViolationAmountPrecision}},
+		{"FOK fillable", func(a *clob_types.OrderArgs) { a.Price, a.Size = d("0.55"), d("20") }, types.OrderTypeFOK, false, nil},
+		{"FOK not fillable", func(a *clob_types.OrderArgs) { a.Size = d("20") }, types.OrderTypeFOK, false, []ViolationCode{ViolationInsufficientLiquidity}},
+		{"post-only FOK", func(a *clob_types.OrderArgs) {}, types.OrderTypeFOK, true, []ViolationCode{ViolationPostOnlyOrderType}},
+		{"post-only crossing", func(a *clob_types.OrderArgs) {}, types.OrderTypeGTC, true, []ViolationCode{ViolationPostOnlyCrosses}},
+		{"post-only resting", func(a *clob_types.OrderArgs) { a.Price = d("0.49") }, types.OrderTypeGTC, true, nil},
+		{"private order", func(a *clob_types.OrderArgs) { a.Taker = common.HexToAddress("0x1") }, types.OrderTypeGTC, false, []ViolationCode{ViolationTakerNotAllowed}},
+		{"unknown token", func(a *clob_types.OrderArgs) { a.TokenID = "3" }, types.OrderTypeGTC, false, []ViolationCode{ViolationUnknownToken}},
+		{"several violations", func(a *clob_types.OrderArgs) { a.Side, a.Price = "", d("0") }, types.OrderTypeGTC, false, []ViolationCode{ViolationInvalidSide, ViolationPriceOutOfRange}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := valid
+			tt.modify(&args)
+			option := clob_types.PartialCreateOrderOptions{OrderType: tt.orderType, PostOnly: tt.postOnly}
+			got := violationCodes(ValidateOrder(args, option, vctx))
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("expected %v, got %v", tt.want, got)
+				}
+			}
+		})
+	}
+
+	closed := vctx
+	closed.Market = &types.Market{ConditionID: "0xc", Closed: true, Tokens: vctx.Market.Tokens}
+	if got := violationCodes(ValidateOrder(valid, clob_types.PartialCreateOrderOptions{}, closed)); len(got) != 1 || got[0] != ViolationMarketClosed {
+		t.Fatalf("expected market_closed, got %v", got)
+	}
+	if got := violationCodes(ValidateOrder(valid, clob_types.PartialCreateOrderOptions{}, OrderValidationContext{TickSize: "0.5"})); len(got) != 1 || got[0] != ViolationInvalidTickSize {
+		t.Fatalf("expected invalid_tick_size, got %v", got)
+	}
+}
+
+func TestValidateMarketOrder(t *testing.T) {
+	d := decimal.RequireFromString
+	vctx := OrderValidationContext{
+		TickSize:     types.TickSize001,
+		MinOrderSize: d("5"),
+		Book:         &types.OrderBookSummary{Asks: []types.OrderSummary{{Price: "0.50", Size: "10"}}},
+	}
+	args := clob_types.MarketOrderArgs{TokenID: "1", Amount: d("4"), Price: d("0.5"), Side: types.SideBuy}
+	if got := ValidateMarketOrder(args, clob_types.PartialCreateOrderOptions{}, vctx); len(got) != 0 {
+		t.Fatalf("expected no violations, got %v", got)
+	}
+	args.Amount = d("2")
+	if got := violationCodes(ValidateMarketOrder(args, clob_types.PartialCreateOrderOptions{}, vctx)); len(got) != 1 || got[0] != ViolationBelowMinSize {
+		t.Fatalf("expected below_min_size, got %v", got)
+	}
+	args.Amount = d("6")
+	if got := violationCodes(ValidateMarketOrder(args, clob_types.PartialCreateOrderOptions{}, vctx)); len(got) != 1 || got[0] != ViolationInsufficientLiquidity {
+		t.Fatalf("expected insufficient_liquidity, got %v", got)
+	}
+	args.OrderType = types.OrderTypeGTC
+	if got := violationCodes(ValidateMarketOrder(args, clob_types.PartialCreateOrderOptions{}, vctx)); len(got) != 1 || got[0] != ViolationInvalidOrderType {
+		t.Fatalf("expected invalid_order_type, got %v", got)
+	}
+}
+
+func TestClobClient_ValidateOrder(t *testing.T) {
+	exchange := newFakeExchange(t, map[string]string{
+		"/book":        `{"market":"0xc","asset_id":"1","bids":[],"asks":[],"min_order_size":"5","tick_size":"0.01"}`,
+		"/markets/0xc": `{"condition_id":"0xc","active":true,"closed":false,"accepting_orders":false,"tokens":[{"token_id":"1"}]}`,
+	})
+
+	clobClient := newTestPrivateKeyClient(t, exchange.URL)
+	// validation reads the synced offset and never syncs the clock itself
+	clobClient.clock = headers.NewClockSync(func(context.Context) (int64, error) {
+		t.Error("validating an order must not request the server time")
+		return 0, errors.New("unexpected sync")
+	}, time.Minute)
+	option := testOrderOptions("")
+	args := clob_types.OrderArgs{TokenID: "1", Price: decimal.RequireFromString("0.5"), Size: decimal.NewFromInt(2), Side: types.SideBuy}
+
+	violations, err := clobClient.ValidateOrder(args, option)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := violationCodes(violations)
+	if len(got) != 2 || got[0] != ViolationBelowMinSize || got[1] != ViolationNotAcceptingOrders {
+		t.Fatalf("expected below_min_size and not_accepting_orders, got %v", got)
+	}
+
+	// orders are checked before signing, without a request to the exchange
+	args.Price = decimal.RequireFromString("1.5")
+	_, err = clobClient.CreateAndPostOrder(args, option)
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) || !validationErr.Has(ViolationPriceOutOfRange) {
+		t.Fatalf("expected a price_out_of_range validation error, got %v", err)
+	}
+
+	// with the metadata cache, orders are also checked against the market ValidateOrder
+	// fetched; prices off the tick are left to the order builder to round
+	clobClient.metadata = newMetadataCache(MetadataCacheConfig{})
+	if _, err := clobClient.ValidateOrder(args, option); err != nil {
+		t.Fatal(err)
+	}
+	args.Price, args.Size = decimal.RequireFromString("0.505"), decimal.NewFromInt(10)
+	_, err = clobClient.CreateAndPostOrder(args, option)
+	if !errors.As(err, &validationErr) || !validationErr.Has(ViolationNotAcceptingOrders) || validationErr.Has(ViolationPriceNotOnTick) {
+		t.Fatalf("expected only a not_accepting_orders validation error, got %v", err)
+	}
+}
+
+func TestClobClient_PostOnly(t *testing.T) {
+	exchange := newFakeExchange(t, map[string]string{
+		"/order": `{"success":true,"orderID":"0x1","status":"live"}`,
+	})
+
+	clobClient := newTestPrivateKeyClient(t, exchange.URL)
+	option := testOrderOptions(types.OrderTypeGTD)
+	option.PostOnly = true
+	args := clob_types.OrderArgs{TokenID: "1", Price: decimal.RequireFromString("0.5"), Size: decimal.NewFromInt(10), Side: types.SideBuy}
+
+	expiration, err := clobClient.GTDExpirationIn(time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	args.Expiration = expiration
+	if _, err := clobClient.CreateAndPostOrder(args, option); err != nil {
+		t.Fatal(err)
+	}
+	posted := exchange.Posted()
+	if len(posted) != 1 || !posted[0].PostOnly || posted[0].OrderType != string(types.OrderTypeGTD) || posted[0].Order.Expiration != strconv.Itoa(expiration) {
+		t.Fatalf("unexpected posted order: %+v", posted)
+	}
+
+	option.OrderType = types.OrderTypeFOK
+	args.Expiration = 0
+	_, err = clobClient.CreateAndPostOrder(args, option)
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) || !validationErr.Has(ViolationPostOnlyOrderType) {
+		t.Fatalf("expected a post_only_order_type validation error, got %v", err)
+	}
+}
@@ -0,0 +1,197 @@
+package clob
+
+import (
+	"context"
+	"encoding/base64"
+	"net/url"
+	"strconv"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ybina/polymarket-go/client/types"
+)
+
+// pageFetcher fetches the page starting at cursor and returns its items and the next cursor.
+type pageFetcher[T any] func(ctx context.Context, cursor string) ([]T, string, error)
+
+// Iterator walks a cursor paginated endpoint one item at a time, fetching pages lazily.
+//
+//	it := client.IterOpenOrders(ctx, funder, nil)
+//	for it.Next() {
+//		order := it.Value()
+//	}
+//	if err := it.Err(); err != nil { ... }
+type Iterator[T any] struct {
+	ctx    context.Context
+	fetch  pageFetcher[T]
+	cursor string
+	page   []T
+	pos    int
+	cur    T
+	err    error
+	done   bool
+}
+
+func newIterator[T any](ctx context.Context, cursor string, fetch pageFetcher[T]) *Iterator[T] {
+	if cursor == "" {
+		cursor = types.INITIAL_CURSOR
+	}
+	return &Iterator[T]{ctx: ctx, fetch: fetch, cursor: cursor}
+}
+
+// Next advances to the next item, fetching the next page when needed.
+// It returns false when the last page is exhausted or an error occurred.
+func (it *Iterator[T]) Next() bool {
+	for it.pos >= len(it.page) {
+		if it.done || it.err != nil {
+			return false
+		}
+		if err := it.ctx.Err(); err != nil {
+			it.err = err
+			return false
+		}
+		page, next, err := it.fetch(it.ctx, it.cursor)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page, it.pos = page, 0
+		if isLastCursor(next) || next == it.cursor {
+			it.done = true
+		}
+		it.cursor = next
+	}
+	it.cur = it.page[it.pos]
+	it.pos++
+	return true
+}
+
+// Value returns the current item.
+func (it *Iterator[T]) Value() T {
+	return it.cur
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Cursor returns the cursor of the next page to fetch, useful to resume later.
+func (it *Iterator[T]) Cursor() string {
+	return it.cursor
+}
+
+// collect drains the iterator.
+func (it *Iterator[T]) collect() ([]T, error) {
+	var all []T
+	for it.Next() {
+		all = append(all, it.Value())
+	}
+	return all, it.Err()
+}
+
+func isLastCursor(cursor string) bool {
+	return cursor == "" || cursor == types.END_CURSOR || cursor == "-1"
+}
+
+type pageResponse[T any] struct {
+	Limit      int    `json:"limit"`
+	Count      int    `json:"count"`
+	NextCursor string `json:"next_cursor"`
+	Data       []T    `json:"data"`
+}
+
+// fetchPage fetches one page of a public paginated endpoint.
+func fetchPage[T any](ctx context.Context, c *ClobClient, path string, params url.Values, cursor string) ([]T, string, error) {
+	queryParams := withCursor(params, cursor)
+	var result pageResponse[T]
+	if err := c.getJSONWithParams(ctx, path, queryParams, &result); err != nil {
+		return nil, "", err
+	}
+	return result.Data, result.NextCursor, nil
+}
+
+// fetchL2Page fetches one page of an L2 authenticated paginated endpoint.
+func fetchL2Page[T any](ctx context.Context, c *ClobClient, path string, signerAddr common.Address, params url.Values, cursor string) ([]T, string, error) {
+	var result pageResponse[T]
+	if err := c.l2Get(ctx, path, signerAddr, withCursor(params, cursor), &result); err != nil {
+		return nil, "", err
+	}
+	return result.Data, result.NextCursor, nil
+}
+
+func withCursor(params url.Values, cursor string) url.Values {
+	queryParams := url.Values{}
+	for k, v := range params {
+		queryParams[k] = append([]string(nil), v...)
+	}
+	if cursor == "" {
+		cursor = types.INITIAL_CURSOR
+	}
+	queryParams.Set("next_cursor", cursor)
+	return queryParams
+}
+
+// collectPages drains a paginated endpoint. With concurrency > 1 and offset cursors
+// (base64 of the item offset, like INITIAL_CURSOR and END_CURSOR) it fetches up to
+// concurrency pages in parallel; otherwise it walks next_cursor one page at a time.
+func collectPages[T any](ctx context.Context, fetch pageFetcher[T], concurrency int) ([]T, error) {
+	first, next, err := fetch(ctx, types.INITIAL_CURSOR)
+	if err != nil {
+		return nil, err
+	}
+	all := first
+	if isLastCursor(next) {
+		return all, nil
+	}
+	step, ok := decodeOffsetCursor(next)
+	if concurrency <= 1 || !ok || step <= 0 {
+		rest, err := newIterator(ctx, next, fetch).collect()
+		return append(all, rest...), err
+	}
+
+	type page struct {
+		items []T
+		next  string
+		err   error
+	}
+	for offset := step; ; offset += step * concurrency {
+		pages := make([]page, concurrency)
+		var wg sync.WaitGroup
+		for i := range pages {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				cursor := encodeOffsetCursor(offset + i*step)
+				pages[i].items, pages[i].next, pages[i].err = fetch(ctx, cursor)
+			}(i)
+		}
+		wg.Wait()
+		// pages past the end come back empty, keep everything up to the first last page
+		for _, p := range pages {
+			if p.err != nil {
+				return nil, p.err
+			}
+			all = append(all, p.items...)
+			if isLastCursor(p.next) || len(p.items) == 0 {
+				return all, nil
+			}
+		}
+	}
+}
+
+func decodeOffsetCursor(cursor string) (int, bool) {
+	b, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, false
+	}
+	n, err := strconv.Atoi(string(b))
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+func encodeOffsetCursor(offset int) string {
+	return base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
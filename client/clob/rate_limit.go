@@ -0,0 +1,196 @@
+package clob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ybina/polymarket-go/client/endpoint"
+)
+
+// ErrRateLimited is returned in fail-fast mode when a request would exceed the local rate limit.
+var ErrRateLimited = errors.New("local rate limit exceeded")
+
+// EndpointFamily groups CLOB endpoints that share a rate limit.
+type EndpointFamily string
+
+const (
+	EndpointFamilyOrderPost EndpointFamily = "order_post"
+	EndpointFamilyCancel    EndpointFamily = "cancel"
+	EndpointFamilyBook      EndpointFamily = "book"
+	EndpointFamilyData      EndpointFamily = "data"
+)
+
+// RateLimit is a token bucket refilled with Rate tokens per second and holding at most Burst tokens.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// RateLimiterConfig configures the client side rate limiter. Families without a limit are not throttled.
+type RateLimiterConfig struct {
+	Limits map[EndpointFamily]RateLimit
+	// FailFast returns ErrRateLimited instead of waiting for a token.
+	FailFast bool
+}
+
+// DefaultRateLimits stays below the published CLOB limits: sustained order
+// placement and cancellation, and the burst limits of book and data reads.
+func DefaultRateLimits() map[EndpointFamily]RateLimit {
+	return map[EndpointFamily]RateLimit{
+		EndpointFamilyOrderPost: {Rate: 60, Burst: 350},
+		EndpointFamilyCancel:    {Rate: 50, Burst: 300},
+		EndpointFamilyBook:      {Rate: 150, Burst: 150},
+		EndpointFamilyData:      {Rate: 20, Burst: 20},
+	}
+}
+
+// RateLimiterStats reports how much an endpoint family was throttled.
+type RateLimiterStats struct {
+	Requests      int64
+	Throttled     int64
+	Rejected      int64
+	ThrottledTime time.Duration
+}
+
+type rateLimiter struct {
+	failFast bool
+	buckets  map[EndpointFamily]*tokenBucket
+}
+
+func newRateLimiter(cfg RateLimiterConfig) *rateLimiter {
+	limits := cfg.Limits
+	if limits == nil {
+		limits = DefaultRateLimits()
+	}
+	rl := &rateLimiter{
+		failFast: cfg.FailFast,
+		buckets:  make(map[EndpointFamily]*tokenBucket, len(limits)),
+	}
+	for family, limit := range limits {
+		if limit.Rate <= 0 {
+			continue
+		}
+		burst := float64(limit.Burst)
+		if burst < 1 {
+			burst = 1
+		}
+		rl.buckets[family] = &tokenBucket{rate: limit.Rate, burst: burst, tokens: burst, last: time.Now()}
+	}
+	return rl
+}
+
+// wait takes a token for the family of the request, blocking until one is available
+// unless the limiter fails fast. A nil limiter never throttles.
+func (rl *rateLimiter) wait(ctx context.Context, method, path string) error {
+	if rl == nil {
+		return nil
+	}
+	family := endpointFamily(method, path)
+	b, ok := rl.buckets[family]
+	if !ok {
+		return nil
+	}
+	if err := b.take(ctx, rl.failFast); err != nil {
+		if errors.Is(err, ErrRateLimited) {
+			return fmt.Errorf("%s %s: %w", method, path, err)
+		}
+		return err
+	}
+	return nil
+}
+
+func (rl *rateLimiter) stats() map[EndpointFamily]RateLimiterStats {
+	if rl == nil {
+		return nil
+	}
+	out := make(map[EndpointFamily]RateLimiterStats, len(rl.buckets))
+	for family, b := range rl.buckets {
+		b.mu.Lock()
+		out[family] = b.stats
+		b.mu.Unlock()
+	}
+	return out
+}
+
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	stats  RateLimiterStats
+}
+
+// take reserves a token and sleeps until it is refilled. Tokens may go negative
+// so that concurrent waiters are served in order.
+func (b *tokenBucket) take(ctx context.Context, failFast bool) error {
+	b.mu.Lock()
+	now := time.Now()
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	b.stats.Requests++
+	if b.tokens >= 1 {
+		b.tokens--
+		b.mu.Unlock()
+		return nil
+	}
+	if failFast {
+		b.stats.Rejected++
+		b.mu.Unlock()
+		return ErrRateLimited
+	}
+	wait := time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+	b.tokens--
+	b.stats.Throttled++
+	b.mu.Unlock()
+
+	start := time.Now()
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		b.mu.Lock()
+		b.tokens++
+		b.stats.ThrottledTime += time.Since(start)
+		b.mu.Unlock()
+		return ctx.Err()
+	case <-timer.C:
+	}
+	b.mu.Lock()
+	b.stats.ThrottledTime += time.Since(start)
+	b.mu.Unlock()
+	return nil
+}
+
+// endpointFamily maps a request to the rate limit it counts against. A query
+// string on the path is ignored.
+func endpointFamily(method, path string) EndpointFamily {
+	path, _, _ = strings.Cut(path, "?")
+	switch {
+	case method == "POST" && (path == endpoint.PostOrder || path == endpoint.PostOrders):
+		return EndpointFamilyOrderPost
+	case method == "DELETE" && path != endpoint.DeleteApiKey && path != endpoint.DropNotifications:
+		return EndpointFamilyCancel
+	}
+	switch path {
+	case endpoint.GetOrderBook, endpoint.GetOrderBooks,
+		endpoint.GetPrice, endpoint.GetPrices,
+		endpoint.GetMidpoint, endpoint.GetMidpoints,
+		endpoint.GetSpread, endpoint.GetSpreads,
+		endpoint.GetLastTradePrice, endpoint.GetLastTradesPrices,
+		endpoint.GetTickSize, endpoint.GetNegRisk, endpoint.GetFeeRate:
+		return EndpointFamilyBook
+	}
+	return EndpointFamilyData
+}
+
+// RateLimiterStats returns the throttling statistics per endpoint family, or nil
+// when the client has no rate limiter.
+func (c *ClobClient) RateLimiterStats() map[EndpointFamily]RateLimiterStats {
+	return c.rateLimiter.stats()
+}
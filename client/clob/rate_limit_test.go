@@ -0,0 +1,118 @@
+package clob
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ybina/polymarket-go/client/types"
+)
+
+func TestEndpointFamily(t *testing.T) {
+	cases := []struct {
+		method, path string
+		want         EndpointFamily
+	}{
+		{"POST", "/order", EndpointFamilyOrderPost},
+		{"POST", "/orders", EndpointFamilyOrderPost},
+		{"DELETE", "/orders", EndpointFamilyCancel},
+		{"DELETE", "/cancel-all", EndpointFamilyCancel},
+		{"DELETE", "/auth/api-key", EndpointFamilyData},
+		{"DELETE", "/notifications?ids=1%2C2", EndpointFamilyData},
+		{"GET", "/book?token_id=1", EndpointFamilyBook},
+		{"GET", "/book", EndpointFamilyBook},
+		{"POST", "/books", EndpointFamilyBook},
+		{"GET", "/midpoint", EndpointFamilyBook},
+		{"GET", "/data/trades", EndpointFamilyData},
+		{"GET", "/markets", EndpointFamilyData},
+	}
+	for _, tc := range cases {
+		if got := endpointFamily(tc.method, tc.path); got != tc.want {
+			t.Errorf("%s %s: got %s, want %s", tc.method, tc.path, got, tc.want)
+		}
+	}
+}
+
+func TestClobClient_RateLimiter(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"market":"0x1","asset_id":"1","bids":[],"asks":[]}`))
+	}))
+	defer srv.Close()
+
+	clobClient := newTestPrivateKeyClient(t, srv.URL)
+	clobClient.rateLimiter = newRateLimiter(RateLimiterConfig{
+		Limits: map[EndpointFamily]RateLimit{EndpointFamilyBook: {Rate: 50, Burst: 2}},
+	})
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := clobClient.GetOrderBook("1"); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	// 2 requests pass immediately, the other 4 are spread at 20ms
+	if elapsed := time.Since(start); elapsed < 70*time.Millisecond {
+		t.Fatalf("requests were not throttled: %s", elapsed)
+	}
+	stats := clobClient.RateLimiterStats()[EndpointFamilyBook]
+	if stats.Requests != 6 || stats.Throttled != 4 || stats.ThrottledTime <= 0 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+	if _, ok := clobClient.RateLimiterStats()[EndpointFamilyData]; ok {
+		t.Fatal("families without limit must not be tracked")
+	}
+
+	clobClient.rateLimiter = newRateLimiter(RateLimiterConfig{
+		Limits:   map[EndpointFamily]RateLimit{EndpointFamilyBook: {Rate: 1, Burst: 1}},
+		FailFast: true,
+	})
+	if _, err := clobClient.GetOrderBook("1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := clobClient.GetOrderBook("1"); !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("expected ErrRateLimited, got %v", err)
+	}
+	if stats := clobClient.RateLimiterStats()[EndpointFamilyBook]; stats.Rejected != 1 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+
+	clobClient.rateLimiter = newRateLimiter(RateLimiterConfig{
+		Limits: map[EndpointFamily]RateLimit{EndpointFamilyBook: {Rate: 0.1, Burst: 1}},
+	})
+	_, _ = clobClient.GetOrderBook("1")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := clobClient.GetOrderBookWithContext(ctx, "1"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the wait to honor the context, got %v", err)
+	}
+}
+
+func TestClobClient_RateLimiter_DropNotifications(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/time" {
+			_, _ = w.Write([]byte("1700000000"))
+		}
+	}))
+	defer srv.Close()
+
+	clobClient := newTestPrivateKeyClient(t, srv.URL)
+	clobClient.rateLimiter = newRateLimiter(RateLimiterConfig{})
+	if err := clobClient.DropNotifications(types.DropNotificationParams{IDs: []string{"1", "2"}}, common.Address{}); err != nil {
+		t.Fatal(err)
+	}
+	stats := clobClient.RateLimiterStats()
+	if stats[EndpointFamilyCancel].Requests != 0 || stats[EndpointFamilyData].Requests != 1 {
+		t.Fatalf("dropping notifications must count against the data limit: %+v", stats)
+	}
+}
@@ -0,0 +1,120 @@
+package clob
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ybina/polymarket-go/client/endpoint"
+	"github.com/ybina/polymarket-go/client/types"
+)
+
+// Dates passed to the rewards endpoints are UTC days formatted as 2006-01-02.
+
+// GetEarningsForUserForDay returns the per-market reward earnings of the funder on date.
+func (c *ClobClient) GetEarningsForUserForDay(date string, signerAddr common.Address) ([]types.UserEarning, error) {
+	return c.GetEarningsForUserForDayWithContext(context.Background(), date, signerAddr)
+}
+
+func (c *ClobClient) GetEarningsForUserForDayWithContext(ctx context.Context, date string, signerAddr common.Address) ([]types.UserEarning, error) {
+	return c.IterEarningsForUserForDay(ctx, date, signerAddr).collect()
+}
+
+func (c *ClobClient) IterEarningsForUserForDay(ctx context.Context, date string, signerAddr common.Address) *Iterator[types.UserEarning] {
+	params := url.Values{}
+	params.Add("date", date)
+	params.Add("signature_type", strconv.Itoa(int(c.signatureType())))
+	return newIterator(ctx, types.INITIAL_CURSOR, func(ctx context.Context, cursor string) ([]types.UserEarning, string, error) {
+		return fetchL2Page[types.UserEarning](ctx, c, endpoint.GetEarningsForUserForDay, signerAddr, params, cursor)
+	})
+}
+
+// GetTotalEarningsForUserForDay returns the funder's reward earnings on date summed per asset.
+func (c *ClobClient) GetTotalEarningsForUserForDay(date string, signerAddr common.Address) ([]types.TotalUserEarning, error) {
+	return c.GetTotalEarningsForUserForDayWithContext(context.Background(), date, signerAddr)
+}
+
+func (c *ClobClient) GetTotalEarningsForUserForDayWithContext(ctx context.Context, date string, signerAddr common.Address) ([]types.TotalUserEarning, error) {
+	params := url.Values{}
+	params.Add("date", date)
+	params.Add("signature_type", strconv.Itoa(int(c.signatureType())))
+	var result []types.TotalUserEarning
+	if err := c.l2Get(ctx, endpoint.GetTotalEarningsForUserForDay, signerAddr, params, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetUserEarningsAndMarketsConfig returns the funder's earnings on date together with the
+// reward configuration of each market.
+func (c *ClobClient) GetUserEarningsAndMarketsConfig(date string, params *types.UserRewardsMarketsParams, signerAddr common.Address) ([]types.UserRewardsEarning, error) {
+	return c.GetUserEarningsAndMarketsConfigWithContext(context.Background(), date, params, signerAddr)
+}
+
+func (c *ClobClient) GetUserEarningsAndMarketsConfigWithContext(ctx context.Context, date string, params *types.UserRewardsMarketsParams, signerAddr common.Address) ([]types.UserRewardsEarning, error) {
+	return c.IterUserEarningsAndMarketsConfig(ctx, date, params, signerAddr).collect()
+}
+
+func (c *ClobClient) IterUserEarningsAndMarketsConfig(ctx context.Context, date string, params *types.UserRewardsMarketsParams, signerAddr common.Address) *Iterator[types.UserRewardsEarning] {
+	queryParams := url.Values{}
+	queryParams.Add("date", date)
+	queryParams.Add("signature_type", strconv.Itoa(int(c.signatureType())))
+	if params != nil {
+		if params.OrderBy != "" {
+			queryParams.Add("order_by", params.OrderBy)
+		}
+		if params.Position != "" {
+			queryParams.Add("position", params.Position)
+		}
+		queryParams.Add("no_competition", strconv.FormatBool(params.NoCompetition))
+	}
+	return newIterator(ctx, types.INITIAL_CURSOR, func(ctx context.Context, cursor string) ([]types.UserRewardsEarning, string, error) {
+		return fetchL2Page[types.UserRewardsEarning](ctx, c, endpoint.GetRewardsEarningsPercentages, signerAddr, queryParams, cursor)
+	})
+}
+
+// GetLiquidityRewardPercentages returns the funder's share of rewards keyed by condition id.
+func (c *ClobClient) GetLiquidityRewardPercentages(signerAddr common.Address) (types.RewardsPercentages, error) {
+	return c.GetLiquidityRewardPercentagesWithContext(context.Background(), signerAddr)
+}
+
+func (c *ClobClient) GetLiquidityRewardPercentagesWithContext(ctx context.Context, signerAddr common.Address) (types.RewardsPercentages, error) {
+	params := url.Values{}
+	params.Add("signature_type", strconv.Itoa(int(c.signatureType())))
+	result := make(types.RewardsPercentages)
+	if err := c.l2Get(ctx, endpoint.GetLiquidityRewardPercentages, signerAddr, params, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetCurrentRewards returns every market with an active rewards program.
+func (c *ClobClient) GetCurrentRewards() ([]types.MarketReward, error) {
+	return c.GetCurrentRewardsWithContext(context.Background())
+}
+
+func (c *ClobClient) GetCurrentRewardsWithContext(ctx context.Context) ([]types.MarketReward, error) {
+	return c.IterCurrentRewards(ctx).collect()
+}
+
+func (c *ClobClient) IterCurrentRewards(ctx context.Context) *Iterator[types.MarketReward] {
+	return newIterator(ctx, types.INITIAL_CURSOR, func(ctx context.Context, cursor string) ([]types.MarketReward, string, error) {
+		return fetchPage[types.MarketReward](ctx, c, endpoint.GetRewardsMarketsCurrent, url.Values{}, cursor)
+	})
+}
+
+// GetRewardsForMarket returns the reward configurations of a market.
+func (c *ClobClient) GetRewardsForMarket(conditionID string) ([]types.MarketReward, error) {
+	return c.GetRewardsForMarketWithContext(context.Background(), conditionID)
+}
+
+func (c *ClobClient) GetRewardsForMarketWithContext(ctx context.Context, conditionID string) ([]types.MarketReward, error) {
+	if conditionID == "" {
+		return nil, fmt.Errorf("condition id is required")
+	}
+	return newIterator(ctx, types.INITIAL_CURSOR, func(ctx context.Context, cursor string) ([]types.MarketReward, string, error) {
+		return fetchPage[types.MarketReward](ctx, c, endpoint.GetRewardsMarkets+conditionID, url.Values{}, cursor)
+	}).collect()
+}
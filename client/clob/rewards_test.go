@@ -0,0 +1,58 @@
+package clob
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ybina/polymarket-go/client/types"
+)
+
+func TestClobClient_Rewards(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch r.URL.Path {
+		case "/rewards/user":
+			if r.Header.Get("POLY_API_KEY") != "test-key" || q.Get("date") != "2024-01-02" || q.Get("signature_type") != "0" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			if q.Get("next_cursor") == types.INITIAL_CURSOR {
+				_, _ = w.Write([]byte(`{"limit":1,"count":1,"next_cursor":"MQ==","data":[{"condition_id":"0xa","earnings":1.5}]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"limit":1,"count":1,"next_cursor":"LTE=","data":[{"condition_id":"0xb","earnings":2}]}`))
+		case "/rewards/user/total":
+			_, _ = w.Write([]byte(`[{"date":"2024-01-02","earnings":3.5}]`))
+		case "/rewards/user/percentages":
+			_, _ = w.Write([]byte(`{"0xa":12.5}`))
+		case "/rewards/markets/current":
+			_, _ = w.Write([]byte(`{"next_cursor":"LTE=","data":[{"condition_id":"0xa","rewards_max_spread":3,"rewards_config":[{"rate_per_day":100}]}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	clobClient := newTestPrivateKeyClient(t, srv.URL)
+	earnings, err := clobClient.GetEarningsForUserForDay("2024-01-02", common.Address{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(earnings) != 2 || earnings[1].ConditionID != "0xb" {
+		t.Fatalf("unexpected earnings: %+v", earnings)
+	}
+	total, err := clobClient.GetTotalEarningsForUserForDay("2024-01-02", common.Address{})
+	if err != nil || len(total) != 1 || total[0].Earnings != 3.5 {
+		t.Fatalf("total earnings: %+v %v", total, err)
+	}
+	percentages, err := clobClient.GetLiquidityRewardPercentages(common.Address{})
+	if err != nil || percentages["0xa"] != 12.5 {
+		t.Fatalf("percentages: %v %v", percentages, err)
+	}
+	rewards, err := clobClient.GetCurrentRewards()
+	if err != nil || len(rewards) != 1 || rewards[0].RewardsConfig[0].RatePerDay != 100 {
+		t.Fatalf("current rewards: %+v %v", rewards, err)
+	}
+}
@@ -0,0 +1,229 @@
+package clob
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"regexp"
+
+	"github.com/bytedance/sonic"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ybina/polymarket-go/client/clob/clob_types"
+	"github.com/ybina/polymarket-go/client/clob/utils_order_builder"
+	"github.com/ybina/polymarket-go/client/constants"
+	"github.com/ybina/polymarket-go/client/signer"
+	"github.com/ybina/polymarket-go/client/types"
+)
+
+// SignedOrderPayloadVersion is the version of the signed order wire format.
+const SignedOrderPayloadVersion = 1
+
+var signaturePattern = regexp.MustCompile(`^0x[0-9a-fA-F]{130}$`)
+
+// SignedOrderPayload is the wire format of a signed order, handed from a signing service
+// to the service posting it. Order has the JSON shape the exchange accepts; OrderType and
+// PostOnly are the posting options chosen when the order was signed. Payloads are
+// created with NewSignedOrderPayload and encoded with sonic like the other bodies of
+// this package.
+type SignedOrderPayload struct {
+	Version   int               `json:"version"`
+	Order     types.SignedOrder `json:"order"`
+	OrderType types.OrderType   `json:"orderType"`
+	PostOnly  bool              `json:"postOnly,omitempty"`
+}
+
+// NewSignedOrderPayload wraps a signed order with the order type and post-only flag of option.
+// The order type defaults to GTC.
+func NewSignedOrderPayload(order utils_order_builder.SignedOrder, option clob_types.PartialCreateOrderOptions) SignedOrderPayload {
+	if option.OrderType == "" {
+		option.OrderType = types.OrderTypeGTC
+	}
+	return SignedOrderPayload{
+		Version:   SignedOrderPayloadVersion,
+		Order:     wireOrder(order),
+		OrderType: option.OrderType,
+		PostOnly:  option.PostOnly,
+	}
+}
+
+// ParseSignedOrderPayload decodes and validates a payload produced by NewSignedOrderPayload.
+func ParseSignedOrderPayload(data []byte) (*SignedOrderPayload, error) {
+	var p SignedOrderPayload
+	if err := sonic.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("failed to decode signed order: %w", err)
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// Validate checks that the payload is well formed. It does not verify the signature.
+func (p SignedOrderPayload) Validate() error {
+	if p.Version != SignedOrderPayloadVersion {
+		return fmt.Errorf("unsupported signed order version %d", p.Version)
+	}
+	switch p.OrderType {
+	case types.OrderTypeGTC, types.OrderTypeGTD, types.OrderTypeFOK, types.OrderTypeFAK:
+	default:
+		return fmt.Errorf("invalid signed order: unknown order type %q", p.OrderType)
+	}
+	if p.PostOnly && p.OrderType != types.OrderTypeGTC && p.OrderType != types.OrderTypeGTD {
+		return fmt.Errorf("invalid signed order: post-only orders must be %s or %s, got %s", types.OrderTypeGTC, types.OrderTypeGTD, p.OrderType)
+	}
+	o := p.Order
+	for name, addr := range map[string]string{"maker": o.Maker, "signer": o.Signer, "taker": o.Taker} {
+		if !common.IsHexAddress(addr) {
+			return fmt.Errorf("invalid signed order: %s %q is not an address", name, addr)
+		}
+	}
+	for name, v := range map[string]string{
+		"tokenId": o.TokenID, "makerAmount": o.MakerAmount, "takerAmount": o.TakerAmount,
+		"expiration": o.Expiration, "nonce": o.Nonce, "feeRateBps": o.FeeRateBps,
+	} {
+		if n, ok := new(big.Int).SetString(v, 10); !ok || n.Sign() < 0 {
+			return fmt.Errorf("invalid signed order: %s %q is not a non-negative integer", name, v)
+		}
+	}
+	if o.Side != string(types.SideBuy) && o.Side != string(types.SideSell) {
+		return fmt.Errorf("invalid signed order: side must be BUY or SELL, got %q", o.Side)
+	}
+	if sigType := constants.SigType(o.SignatureType); sigType != constants.EOA && sigType != constants.POLY_PROXY && sigType != constants.POLY_GNOSIS_SAFE {
+		return fmt.Errorf("invalid signed order: unknown signature type %d", o.SignatureType)
+	}
+	if !signaturePattern.MatchString(o.Signature) {
+		return fmt.Errorf("invalid signed order: signature must be 65 hex encoded bytes")
+	}
+	return nil
+}
+
+// SignedOrder returns the order of a valid payload in the form the order builder produces.
+func (p SignedOrderPayload) SignedOrder() (utils_order_builder.SignedOrder, error) {
+	if err := p.Validate(); err != nil {
+		return utils_order_builder.SignedOrder{}, err
+	}
+	o := p.Order
+	return utils_order_builder.SignedOrder{
+		Salt:          o.Salt,
+		Maker:         common.HexToAddress(o.Maker),
+		Signer:        common.HexToAddress(o.Signer),
+		Taker:         common.HexToAddress(o.Taker),
+		TokenID:       o.TokenID,
+		MakerAmount:   o.MakerAmount,
+		TakerAmount:   o.TakerAmount,
+		Expiration:    o.Expiration,
+		Nonce:         o.Nonce,
+		FeeRateBps:    o.FeeRateBps,
+		Side:          o.Side,
+		SignatureType: uint8(o.SignatureType),
+		Signature:     o.Signature,
+	}, nil
+}
+
+// SignOrder creates and signs a limit order without posting it. It needs L1 auth only,
+// so orders can be signed by a service that holds no API credentials.
+func (c *ClobClient) SignOrder(args clob_types.OrderArgs, option clob_types.PartialCreateOrderOptions) (utils_order_builder.SignedOrder, error) {
+	return c.SignOrderWithContext(context.Background(), args, option)
+}
+
+func (c *ClobClient) SignOrderWithContext(ctx context.Context, args clob_types.OrderArgs, option clob_types.PartialCreateOrderOptions) (utils_order_builder.SignedOrder, error) {
+	if err := c.assertTradingAccounts(option); err != nil {
+		return utils_order_builder.SignedOrder{}, err
+	}
+	return c.createOrder(ctx, args, option)
+}
+
+// SignMarketOrder creates and signs a market order without posting it.
+func (c *ClobClient) SignMarketOrder(args clob_types.MarketOrderArgs, option clob_types.PartialCreateOrderOptions) (utils_order_builder.SignedOrder, error) {
+	return c.SignMarketOrderWithContext(context.Background(), args, option)
+}
+
+func (c *ClobClient) SignMarketOrderWithContext(ctx context.Context, args clob_types.MarketOrderArgs, option clob_types.PartialCreateOrderOptions) (utils_order_builder.SignedOrder, error) {
+	if err := c.assertTradingAccounts(option); err != nil {
+		return utils_order_builder.SignedOrder{}, err
+	}
+	return c.createMarketOrder(ctx, args, option)
+}
+
+// PostSignedOrder posts an order signed elsewhere. The API credentials of this client are
+// used, which need not belong to the signer of the order. A Turnkey client posts as
+// option.TurnkeyAccount.
+func (c *ClobClient) PostSignedOrder(payload SignedOrderPayload, option clob_types.ClobOption) (*types.OrderResponse, error) {
+	return c.PostSignedOrderWithContext(context.Background(), payload, option)
+}
+
+func (c *ClobClient) PostSignedOrderWithContext(ctx context.Context, payload SignedOrderPayload, option clob_types.ClobOption) (*types.OrderResponse, error) {
+	if err := c.assertPostingAccount(option); err != nil {
+		return nil, err
+	}
+	if err := payload.Validate(); err != nil {
+		return nil, err
+	}
+	body, err := newFinalBody(payload.Order, c.apiCreds(), payload.OrderType, payload.PostOnly)
+	if err != nil {
+		return nil, err
+	}
+	return c.postOrderBody(ctx, body, clob_types.PartialCreateOrderOptions{TurnkeyAccount: option.TurnkeyAccount})
+}
+
+// PostSignedOrders posts orders signed elsewhere in a single /orders request. Payloads that
+// are not valid are reported in their result and not posted.
+func (c *ClobClient) PostSignedOrders(payloads []SignedOrderPayload, option clob_types.ClobOption) ([]BatchOrderResult, error) {
+	return c.PostSignedOrdersWithContext(context.Background(), payloads, option)
+}
+
+func (c *ClobClient) PostSignedOrdersWithContext(ctx context.Context, payloads []SignedOrderPayload, option clob_types.ClobOption) ([]BatchOrderResult, error) {
+	if len(payloads) == 0 {
+		return nil, fmt.Errorf("no orders to post")
+	}
+	if len(payloads) > MaxBatchOrders {
+		return nil, fmt.Errorf("too many orders in batch: %d, max: %d", len(payloads), MaxBatchOrders)
+	}
+	if err := c.assertPostingAccount(option); err != nil {
+		return nil, err
+	}
+
+	results := make([]BatchOrderResult, len(payloads))
+	var posted []int
+	var bodies []*FinalBody
+	for i, p := range payloads {
+		results[i].Index = i
+		order, err := p.SignedOrder()
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		results[i].Order = &order
+		body, err := newFinalBody(p.Order, c.apiCreds(), p.OrderType, p.PostOnly)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		posted = append(posted, i)
+		bodies = append(bodies, body)
+	}
+	if len(posted) == 0 {
+		return results, nil
+	}
+
+	responses, err := c.postOrderBodies(ctx, bodies, clob_types.PartialCreateOrderOptions{TurnkeyAccount: option.TurnkeyAccount})
+	if err != nil {
+		for _, i := range posted {
+			results[i].Err = err
+		}
+		return results, err
+	}
+	applyBatchResponses(results, posted, responses)
+	return results, nil
+}
+
+// assertPostingAccount checks that the client can sign L2 headers for posting orders.
+func (c *ClobClient) assertPostingAccount(option clob_types.ClobOption) error {
+	if err := c.AssertL2Auth(); err != nil {
+		return err
+	}
+	if c.signer.SignerType() == signer.Turnkey && option.TurnkeyAccount == constants.ZERO_ADDRESS {
+		return fmt.Errorf("turnkeyAccount is required")
+	}
+	return nil
+}
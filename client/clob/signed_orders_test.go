@@ -0,0 +1,78 @@
+package clob
+
+import (
+	"testing"
+
+	"github.com/bytedance/sonic"
+	"github.com/shopspring/decimal"
+	"github.com/ybina/polymarket-go/client/clob/clob_types"
+	"github.com/ybina/polymarket-go/client/types"
+)
+
+func TestClobClient_SignAndPostSignedOrders(t *testing.T) {
+	exchange := newFakeExchange(t, map[string]string{
+		"/order":  `{"success":true,"orderID":"0x1","status":"live"}`,
+		"/orders": `[{"success":true,"orderID":"0x2","status":"live"}]`,
+	})
+
+	signingClient := newTestPrivateKeyClient(t, exchange.URL)
+	signingClient.verifyOrders = true
+	postingClient := newTestPrivateKeyClient(t, exchange.URL)
+	option := testOrderOptions(types.OrderTypeGTC)
+	option.PostOnly = true
+	args := clob_types.OrderArgs{TokenID: "1", Price: decimal.RequireFromString("0.45"), Size: decimal.NewFromInt(10), Side: types.SideBuy}
+
+	order, err := signingClient.SignOrder(args, option)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := sonic.Marshal(NewSignedOrderPayload(order, option))
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, err := ParseSignedOrderPayload(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	roundTrip, err := payload.SignedOrder()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if roundTrip != order {
+		t.Fatalf("payload changed the order: %+v != %+v", roundTrip, order)
+	}
+
+	resp, err := postingClient.PostSignedOrder(*payload, clob_types.ClobOption{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	posted := exchange.Posted()
+	if resp.OrderID != "0x1" || len(posted) != 1 || posted[0].Order != wireOrder(order) || !posted[0].PostOnly || posted[0].Owner != "test-key" {
+		t.Fatalf("unexpected posted order: %+v", posted)
+	}
+
+	invalid := *payload
+	invalid.Order.Signature = "0x12"
+	results, err := postingClient.PostSignedOrders([]SignedOrderPayload{invalid, *payload}, clob_types.ClobOption{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if results[0].Err == nil || results[0].Response != nil {
+		t.Fatalf("order 0 should fail client side: %+v", results[0])
+	}
+	if results[1].Err != nil || results[1].Response.OrderID != "0x2" || len(exchange.Posted()) != 1 {
+		t.Fatalf("order 1: %+v, posted %d", results[1], len(exchange.Posted()))
+	}
+
+	if _, err := ParseSignedOrderPayload([]byte(`{"version":2}`)); err == nil {
+		t.Fatal("expected an error for an unknown version")
+	}
+	fok := *payload
+	fok.OrderType = types.OrderTypeFOK
+	if data, err = sonic.Marshal(fok); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ParseSignedOrderPayload(data); err == nil {
+		t.Fatal("expected an error for a post-only FOK order")
+	}
+}
@@ -0,0 +1,122 @@
+package clob
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ybina/polymarket-go/client/endpoint"
+	"github.com/ybina/polymarket-go/client/types"
+)
+
+const (
+	defaultTradeEventsInterval = 5 * time.Second
+	tradeEventsSeenLimit       = 5000
+)
+
+// GetMarketTradesEvents returns the most recent public trades of a market, newest first.
+func (c *ClobClient) GetMarketTradesEvents(conditionID string) ([]types.MarketTradeEvent, error) {
+	return c.GetMarketTradesEventsWithContext(context.Background(), conditionID)
+}
+
+func (c *ClobClient) GetMarketTradesEventsWithContext(ctx context.Context, conditionID string) ([]types.MarketTradeEvent, error) {
+	if conditionID == "" {
+		return nil, fmt.Errorf("condition id is required")
+	}
+	var result []types.MarketTradeEvent
+	if err := c.getJSONWithParams(ctx, endpoint.GetMarketTradesEvents+conditionID, nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to get trade events of %s: %w", conditionID, err)
+	}
+	return result, nil
+}
+
+// TradeEventsWatcherOptions configures WatchMarketTradesEvents.
+type TradeEventsWatcherOptions struct {
+	// Interval between polls, 5s when zero.
+	Interval time.Duration
+	// EmitInitial also emits the trades returned by the first poll.
+	EmitInitial bool
+	// OnError is called when a poll fails; the watcher keeps polling.
+	OnError func(error)
+}
+
+// WatchMarketTradesEvents polls the trade events of a market and sends each trade once,
+// oldest first. The channel is closed when ctx is done.
+func (c *ClobClient) WatchMarketTradesEvents(ctx context.Context, conditionID string, opts *TradeEventsWatcherOptions) <-chan types.MarketTradeEvent {
+	if opts == nil {
+		opts = &TradeEventsWatcherOptions{}
+	}
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = defaultTradeEventsInterval
+	}
+	out := make(chan types.MarketTradeEvent)
+	go func() {
+		defer close(out)
+		seen := newSeenSet(tradeEventsSeenLimit)
+		first := true
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			events, err := c.GetMarketTradesEventsWithContext(ctx, conditionID)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				if opts.OnError != nil {
+					opts.OnError(err)
+				}
+			} else {
+				// events come newest first
+				for i := len(events) - 1; i >= 0; i-- {
+					if !seen.add(tradeEventKey(events[i])) || (first && !opts.EmitInitial) {
+						continue
+					}
+					select {
+					case out <- events[i]:
+					case <-ctx.Done():
+						return
+					}
+				}
+				first = false
+			}
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// tradeEventKey identifies a fill; one transaction can settle several fills.
+func tradeEventKey(e types.MarketTradeEvent) string {
+	return e.TransactionHash + "|" + e.User.Address + "|" + string(e.Side) + "|" + e.Price + "|" + e.Size + "|" + strconv.Itoa(e.OutcomeIndex) + "|" + e.Timestamp
+}
+
+// seenSet remembers the last limit keys.
+type seenSet struct {
+	keys  map[string]struct{}
+	order []string
+	limit int
+}
+
+func newSeenSet(limit int) *seenSet {
+	return &seenSet{keys: make(map[string]struct{}), limit: limit}
+}
+
+// add reports whether key was not seen before.
+func (s *seenSet) add(key string) bool {
+	if _, ok := s.keys[key]; ok {
+		return false
+	}
+	s.keys[key] = struct{}{}
+	s.order = append(s.order, key)
+	if len(s.order) > s.limit {
+		delete(s.keys, s.order[0])
+		s.order = s.order[1:]
+	}
+	return true
+}
@@ -0,0 +1,58 @@
+package clob
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ybina/polymarket-go/client/types"
+)
+
+func TestClobClient_WatchMarketTradesEvents(t *testing.T) {
+	var polls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/live-activity/events/0xabc" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch polls.Add(1) {
+		case 1:
+			_, _ = w.Write([]byte(`[{"transaction_hash":"0x2","side":"BUY","size":"5"},{"transaction_hash":"0x1","side":"SELL","size":"3"}]`))
+		default:
+			_, _ = w.Write([]byte(`[{"transaction_hash":"0x4","side":"BUY","size":"1"},{"transaction_hash":"0x3","side":"BUY","size":"2"},{"transaction_hash":"0x2","side":"BUY","size":"5"}]`))
+		}
+	}))
+	defer srv.Close()
+
+	clobClient, err := NewClobClient(&ClientConfig{Host: srv.URL, ChainID: types.ChainPolygon})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := clobClient.WatchMarketTradesEvents(ctx, "0xabc", &TradeEventsWatcherOptions{Interval: 10 * time.Millisecond})
+
+	var got []string
+	for len(got) < 2 {
+		select {
+		case e := <-events:
+			got = append(got, e.TransactionHash)
+		case <-time.After(2 * time.Second):
+			t.Fatalf("timed out, got %v", got)
+		}
+	}
+	if got[0] != "0x3" || got[1] != "0x4" {
+		t.Fatalf("expected only new trades oldest first, got %v", got)
+	}
+	select {
+	case e := <-events:
+		t.Fatalf("unexpected duplicate event %+v", e)
+	case <-time.After(50 * time.Millisecond):
+	}
+	cancel()
+	for range events {
+	}
+}
@@ -46,19 +46,10 @@ func (b *UtilsOrderBuilder) BuildSignedOrder(data OrderData) (SignedOrder, error
 		return SignedOrder{}, err
 	}
 
-	structHash, err := order.OrderStructHash()
+	digest, err := orderDigest(&order, b.ChainId, b.ExchangeAddress)
 	if err != nil {
 		return SignedOrder{}, err
 	}
-	name := "Polymarket CTF Exchange"
-	version := "1"
-	chainId := b.ChainId
-	contract := b.ExchangeAddress.Hex()
-	domain := polyEip712.MakeDomain(&name, &version, &chainId, &contract, nil)
-
-	domainSep, err := domain.HashStruct()
-	domainSepHash := common.BytesToHash(domainSep[:])
-	digest := order.OrderEIP712Digest(domainSepHash, structHash)
 	var sig string
 	if b.Signer.SignerType() == signer.Turnkey {
 		sig, err = b.Signer.SignHashWithTurnkey(digest.Hex(), b.Option.TurnkeyAccount)
@@ -95,6 +86,24 @@ func (b *UtilsOrderBuilder) BuildSignedOrder(data OrderData) (SignedOrder, error
 	}, nil
 }
 
+// orderDigest is the EIP-712 digest of an order for the exchange at the given address.
+func orderDigest(order *Order, chainId int, exchange common.Address) (common.Hash, error) {
+	structHash, err := order.OrderStructHash()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	name := "Polymarket CTF Exchange"
+	version := "1"
+	contract := exchange.Hex()
+	domain := polyEip712.MakeDomain(&name, &version, &chainId, &contract, nil)
+
+	domainSep, err := domain.HashStruct()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return order.OrderEIP712Digest(common.BytesToHash(domainSep[:]), structHash), nil
+}
+
 func (b *UtilsOrderBuilder) buildOrder(data OrderData) (Order, error) {
 	err := b.validateInputs(data)
 	if err != nil {
@@ -0,0 +1,181 @@
+package utils_order_builder
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/shopspring/decimal"
+	"github.com/ybina/polymarket-go/client/clob/utils"
+	"github.com/ybina/polymarket-go/client/config"
+	"github.com/ybina/polymarket-go/client/constants"
+	"github.com/ybina/polymarket-go/client/relayer/builder"
+	"github.com/ybina/polymarket-go/client/types"
+)
+
+// ErrInvalidOrderSignature is returned by VerifySignedOrder when the signature does not
+// belong to the signer of the order.
+var ErrInvalidOrderSignature = errors.New("invalid order signature")
+
+// VerifyOptions is what a signed order is verified against.
+type VerifyOptions struct {
+	ChainID types.Chain
+	// NegRisk selects the neg-risk exchange as the verifying contract.
+	NegRisk bool
+	// Price, when set, is the price the order was created for. The price implied by the
+	// amounts must round to it at the precision of TickSize, or of Price when TickSize is unset.
+	Price    decimal.Decimal
+	TickSize types.TickSize
+}
+
+// VerifySignedOrder checks a signed order before it is posted: the signature must recover
+// to Signer over the EIP-712 digest of the exchange, Maker must be the account the
+// signature type trades for, and the amounts must describe a price between 0 and 1.
+//
+// EOA orders are made by the signer itself, POLY_PROXY orders by the proxy wallet and
+// Gnosis Safe orders by the Safe derived from the signer.
+func VerifySignedOrder(order SignedOrder, opts VerifyOptions) error {
+	contractConfig, err := config.GetContractConfig(opts.ChainID)
+	if err != nil {
+		return err
+	}
+	exchange := contractConfig.Exchange
+	if opts.NegRisk {
+		exchange = contractConfig.NegExchange
+	}
+
+	o, err := order.toOrder()
+	if err != nil {
+		return err
+	}
+	digest, err := orderDigest(o, int(opts.ChainID), exchange)
+	if err != nil {
+		return err
+	}
+	recovered, err := recoverSigner(digest, order.Signature)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidOrderSignature, err)
+	}
+	if recovered != order.Signer {
+		return fmt.Errorf("%w: signed by %s, expected signer %s", ErrInvalidOrderSignature, recovered.Hex(), order.Signer.Hex())
+	}
+
+	switch constants.SigType(order.SignatureType) {
+	case constants.EOA:
+		if order.Maker != order.Signer {
+			return fmt.Errorf("EOA order maker %s must be the signer %s", order.Maker.Hex(), order.Signer.Hex())
+		}
+	case constants.POLY_PROXY:
+		if contractConfig.ProxyFactory == constants.ZERO_ADDRESS {
+			return fmt.Errorf("proxy wallets are not supported on chain %d", opts.ChainID)
+		}
+		if proxy := builder.DeriveProxy(order.Signer, contractConfig.ProxyFactory); order.Maker != proxy {
+			return fmt.Errorf("proxy order maker %s must be the proxy wallet %s of the signer", order.Maker.Hex(), proxy.Hex())
+		}
+	case constants.POLY_GNOSIS_SAFE:
+		if safe := builder.Derive(order.Signer, contractConfig.SafeFactory); order.Maker != safe {
+			return fmt.Errorf("safe order maker %s must be the safe %s of the signer", order.Maker.Hex(), safe.Hex())
+		}
+	default:
+		return fmt.Errorf("unknown signature type %d", order.SignatureType)
+	}
+
+	return verifyAmounts(o, opts)
+}
+
+func verifyAmounts(o *Order, opts VerifyOptions) error {
+	if o.MakerAmount.Sign() <= 0 || o.TakerAmount.Sign() <= 0 {
+		return fmt.Errorf("order amounts must be positive, maker %s, taker %s", o.MakerAmount, o.TakerAmount)
+	}
+	maker := decimal.NewFromBigInt(o.MakerAmount, 0)
+	taker := decimal.NewFromBigInt(o.TakerAmount, 0)
+	// BUY orders give collateral for shares, SELL orders shares for collateral
+	price := maker.Div(taker)
+	if o.Side == 1 {
+		price = taker.Div(maker)
+	}
+	if !price.IsPositive() || price.GreaterThanOrEqual(decimal.NewFromInt(1)) {
+		return fmt.Errorf("order amounts imply a price of %s, outside of (0, 1)", price)
+	}
+	if opts.Price.IsZero() {
+		return nil
+	}
+	places := utils.DecimalPlaces(opts.Price)
+	if round := types.GetRoundConfig(opts.TickSize); round != nil {
+		places = round.Price
+	}
+	if rounded := utils.RoundNormal(price, places); !rounded.Equal(opts.Price) {
+		return fmt.Errorf("order amounts imply a price of %s, expected %s", rounded, opts.Price)
+	}
+	return nil
+}
+
+// toOrder parses the fields of a signed order back into the signed struct.
+func (s SignedOrder) toOrder() (*Order, error) {
+	parse := func(name, v string) (*big.Int, error) {
+		n, ok := new(big.Int).SetString(v, 10)
+		if !ok || n.Sign() < 0 {
+			return nil, fmt.Errorf("invalid %s %q", name, v)
+		}
+		return n, nil
+	}
+	o := &Order{
+		Salt:          big.NewInt(s.Salt),
+		Maker:         s.Maker,
+		Signer:        s.Signer,
+		Taker:         s.Taker,
+		SignatureType: s.SignatureType,
+	}
+	var err error
+	for _, f := range []struct {
+		name  string
+		value string
+		dst   **big.Int
+	}{
+		{"tokenId", s.TokenID, &o.TokenID},
+		{"makerAmount", s.MakerAmount, &o.MakerAmount},
+		{"takerAmount", s.TakerAmount, &o.TakerAmount},
+		{"expiration", s.Expiration, &o.Expiration},
+		{"nonce", s.Nonce, &o.Nonce},
+		{"feeRateBps", s.FeeRateBps, &o.FeeRateBps},
+	} {
+		if *f.dst, err = parse(f.name, f.value); err != nil {
+			return nil, err
+		}
+	}
+	switch s.Side {
+	case string(types.SideBuy):
+		o.Side = 0
+	case string(types.SideSell):
+		o.Side = 1
+	default:
+		return nil, fmt.Errorf("invalid side %q", s.Side)
+	}
+	return o, nil
+}
+
+// recoverSigner returns the address that signed digest. Both 0/1 and 27/28 recovery ids are accepted.
+func recoverSigner(digest common.Hash, signature string) (common.Address, error) {
+	if !strings.HasPrefix(signature, "0x") {
+		signature = "0x" + signature
+	}
+	sig, err := hexutil.Decode(signature)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if len(sig) != crypto.SignatureLength {
+		return common.Address{}, fmt.Errorf("signature must be %d bytes, got %d", crypto.SignatureLength, len(sig))
+	}
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	pub, err := crypto.SigToPub(digest.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
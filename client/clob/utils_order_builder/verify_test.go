@@ -0,0 +1,87 @@
+package utils_order_builder
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/shopspring/decimal"
+	"github.com/ybina/polymarket-go/client/config"
+	"github.com/ybina/polymarket-go/client/constants"
+	"github.com/ybina/polymarket-go/client/relayer/builder"
+	"github.com/ybina/polymarket-go/client/signer"
+	"github.com/ybina/polymarket-go/client/types"
+)
+
+func TestVerifySignedOrder(t *testing.T) {
+	pk, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	signerHandler, err := signer.NewSigner(signer.SignerConfig{
+		SignerType:       signer.PrivateKey,
+		ChainID:          137,
+		PrivateKeyConfig: &signer.PrivateKeyClient{PrivateKey: pk},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	contractConfig, err := config.GetContractConfig(types.ChainPolygon)
+	if err != nil {
+		t.Fatal(err)
+	}
+	orderBuilder, err := NewUtilsOrderBuilder(contractConfig.Exchange, 137, signerHandler, Option{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := crypto.PubkeyToAddress(pk.PublicKey)
+	build := func(data OrderData) SignedOrder {
+		t.Helper()
+		data.Signer, data.TokenID, data.FeeRateBps, data.Nonce, data.Expiration = addr, "123", "0", "0", "0"
+		order, err := orderBuilder.BuildSignedOrder(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return order
+	}
+	// 10 shares at 0.45
+	eoa := build(OrderData{Maker: addr, MakerAmount: "4500000", TakerAmount: "10000000", Side: 0, SignatureType: constants.EOA})
+	safe := build(OrderData{Maker: builder.Derive(addr, contractConfig.SafeFactory), MakerAmount: "10000000", TakerAmount: "4500000", Side: 1, SignatureType: constants.POLY_GNOSIS_SAFE})
+	proxy := build(OrderData{Maker: builder.DeriveProxy(addr, contractConfig.ProxyFactory), MakerAmount: "4500000", TakerAmount: "10000000", Side: 0, SignatureType: constants.POLY_PROXY})
+	wrongProxy := build(OrderData{Maker: builder.Derive(addr, contractConfig.SafeFactory), MakerAmount: "4500000", TakerAmount: "10000000", Side: 0, SignatureType: constants.POLY_PROXY})
+	wrongSafe := build(OrderData{Maker: contractConfig.Exchange, MakerAmount: "10000000", TakerAmount: "4500000", Side: 1, SignatureType: constants.POLY_GNOSIS_SAFE})
+	eoaForOther := build(OrderData{Maker: contractConfig.Exchange, MakerAmount: "4500000", TakerAmount: "10000000", Side: 0, SignatureType: constants.EOA})
+	tampered := eoa
+	tampered.MakerAmount = "4600000"
+
+	opts := VerifyOptions{ChainID: types.ChainPolygon, Price: decimal.RequireFromString("0.45"), TickSize: types.TickSize001}
+	tests := []struct {
+		name    string
+		order   SignedOrder
+		opts    VerifyOptions
+		wantErr bool
+		sigErr  bool
+	}{
+		{"EOA", eoa, opts, false, false},
+		{"safe", safe, opts, false, false},
+		{"proxy", proxy, opts, false, false},
+		{"without price", eoa, VerifyOptions{ChainID: types.ChainPolygon}, false, false},
+		{"wrong exchange", eoa, VerifyOptions{ChainID: types.ChainPolygon, NegRisk: true}, true, true},
+		{"tampered amount", tampered, opts, true, true},
+		{"wrong price", eoa, VerifyOptions{ChainID: types.ChainPolygon, Price: decimal.RequireFromString("0.46")}, true, false},
+		{"safe not derived from signer", wrongSafe, opts, true, false},
+		{"proxy not derived from signer", wrongProxy, opts, true, false},
+		{"EOA maker is not the signer", eoaForOther, opts, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifySignedOrder(tt.order, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("wantErr %v, got %v", tt.wantErr, err)
+			}
+			if errors.Is(err, ErrInvalidOrderSignature) != tt.sigErr {
+				t.Fatalf("expected signature error %v, got %v", tt.sigErr, err)
+			}
+		})
+	}
+}
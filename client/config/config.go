@@ -9,6 +9,8 @@ import (
 )
 
 type ContractConfig struct {
+	// ProxyFactory deploys Polymarket proxy wallets. It is unset on chains without them.
+	ProxyFactory         common.Address
 	SafeFactory          common.Address
 	SafeMultisend        common.Address
 	Exchange             common.Address
@@ -21,6 +23,7 @@ type ContractConfig struct {
 
 var contractConfigs = map[types.Chain]ContractConfig{
 	137: {
+		ProxyFactory:         common.HexToAddress("0xaB45c5A4B0c941a2F231C04C3f49182e1A254052"),
 		SafeFactory:          common.HexToAddress("0xaacFeEa03eb1561C4e67d661e40682Bd20E3541b"),
 		SafeMultisend:        common.HexToAddress("0xA238CBeb142c10Ef7Ad8442C6D1f9E89e07e7761"),
 		Exchange:             common.HexToAddress("0x4bFb41d5B3570DeFd03C39a9A4D8dE6Bd8B8982E"),
@@ -6,6 +6,7 @@ var (
 	PolyExchangeDomainName = "Polymarket CTF Exchange"
 	SAFE_FACTORY_NAME      = "Polymarket Contract Proxy Factory"
 	SAFE_INIT_CODE_HASH    = "0x2bce2127ff07fb632d16c8347c4ebf501f4841168bed00d9e6ef715ddb6fcecf"
+	PROXY_INIT_CODE_HASH   = "0xd21df8dc65880a8606f09fe0ce3df9b8869287ab0b058be05aa9e8af6330a00b"
 	ZERO_ADDRESS           = common.HexToAddress("0x0000000000000000000000000000000000000000")
 	CTF_CONTRACT           = common.HexToAddress("0x4d97dcd97ec945f40cf65f87097ace5ea0476045")
 	CTF_EXCHANGE           = common.HexToAddress("0x4bFb41d5B3570DeFd03C39a9A4D8dE6Bd8B8982E")
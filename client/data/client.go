@@ -1,9 +1,11 @@
 package data
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"reflect"
@@ -11,6 +13,9 @@ import (
 	"time"
 
 	"github.com/bytedance/sonic"
+	"github.com/ybina/polymarket-go/client/retry"
+	"github.com/ybina/polymarket-go/client/types"
+	"github.com/ybina/polymarket-go/tools/logging"
 )
 
 const (
@@ -18,9 +23,11 @@ const (
 )
 
 type DataSDK struct {
-	baseURL    string
-	proxyUrl   *string
-	httpClient *http.Client
+	baseURL     string
+	proxyUrl    *string
+	httpClient  *http.Client
+	retryPolicy *retry.Policy
+	logger      *slog.Logger
 }
 
 func NewDataSDK(proxyUrl *string) (*DataSDK, error) {
@@ -30,9 +37,11 @@ func NewDataSDK(proxyUrl *string) (*DataSDK, error) {
 	}
 
 	client := &DataSDK{
-		baseURL:    DataAPIBase,
-		proxyUrl:   proxyUrl,
-		httpClient: httpClient,
+		baseURL:     DataAPIBase,
+		proxyUrl:    proxyUrl,
+		httpClient:  httpClient,
+		retryPolicy: retry.DefaultPolicy(),
+		logger:      logging.New(nil),
 	}
 	if client.proxyUrl != nil && *client.proxyUrl != "" {
 		proxy, err := url.Parse(*proxyUrl)
@@ -51,6 +60,16 @@ func (d *DataSDK) GetHttpClient() *http.Client {
 	return d.httpClient
 }
 
+// SetRetryPolicy replaces the retry policy of the requests; nil disables retries.
+func (d *DataSDK) SetRetryPolicy(policy *retry.Policy) {
+	d.retryPolicy = policy
+}
+
+// SetLogger replaces the logger of the client; nil uses slog.Default(). Credentials are redacted.
+func (d *DataSDK) SetLogger(logger *slog.Logger) {
+	d.logger = logging.New(logger)
+}
+
 func (d *DataSDK) buildURL(endpoint string, query interface{}) (string, error) {
 	u, err := url.Parse(d.baseURL + endpoint)
 	if err != nil {
@@ -136,6 +155,26 @@ func (d *DataSDK) makeRequest(method, endpoint string, query interface{}) (*APIR
 		return nil, fmt.Errorf("failed to build URL: %w", err)
 	}
 
+	var apiResp *APIResponse
+	err = d.retryPolicy.Do(context.Background(), method == http.MethodGet, func() error {
+		var err error
+		apiResp, err = d.sendRequest(method, fullURL)
+		if err != nil {
+			return err
+		}
+		if apiResp.Err != nil {
+			return apiResp.Err
+		}
+		return nil
+	})
+	// error responses are reported through extractResponseData
+	if err != nil && (apiResp == nil || apiResp.Err == nil) {
+		return nil, err
+	}
+	return apiResp, nil
+}
+
+func (d *DataSDK) sendRequest(method, fullURL string) (*APIResponse, error) {
 	req, err := d.createRequest(method, fullURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
@@ -143,9 +182,11 @@ func (d *DataSDK) makeRequest(method, endpoint string, query interface{}) (*APIR
 
 	resp, err := d.httpClient.Do(req)
 	if err != nil {
+		d.logger.Debug("data request failed", "method", method, "url", req.URL.Path, "error", err)
 		return nil, fmt.Errorf("failed to make request: %w", err)
 	}
 	defer resp.Body.Close()
+	d.logger.Debug("data request", "method", method, "url", req.URL.Path, "status", resp.StatusCode)
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -157,6 +198,10 @@ func (d *DataSDK) makeRequest(method, endpoint string, query interface{}) (*APIR
 		OK:     resp.StatusCode >= 200 && resp.StatusCode < 300,
 	}
 
+	if !apiResp.OK {
+		apiResp.Err = types.NewAPIError(resp, body)
+	}
+
 	if resp.StatusCode == 204 {
 		return apiResp, nil
 	}
@@ -180,6 +225,9 @@ func (d *DataSDK) makeRequest(method, endpoint string, query interface{}) (*APIR
 
 func (d *DataSDK) extractResponseData(resp *APIResponse, operation string) ([]byte, error) {
 	if !resp.OK {
+		if resp.Err != nil {
+			return nil, fmt.Errorf("[DataSDK] %s failed: %w", operation, resp.Err)
+		}
 		return nil, fmt.Errorf("[DataSDK] %s failed: status %d", operation, resp.Status)
 	}
 
@@ -577,4 +625,5 @@ type APIResponse struct {
 	OK        bool            `json:"ok"`
 	Data      json.RawMessage `json:"data,omitempty"`
 	ErrorData interface{}     `json:"errorData,omitempty"`
+	Err       *types.APIError `json:"-"`
 }
@@ -1,9 +1,10 @@
 package gamma
 
 import (
+	"context"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"reflect"
@@ -11,6 +12,9 @@ import (
 	"time"
 
 	"github.com/bytedance/sonic"
+	"github.com/ybina/polymarket-go/client/retry"
+	"github.com/ybina/polymarket-go/client/types"
+	"github.com/ybina/polymarket-go/tools/logging"
 )
 
 const (
@@ -18,9 +22,11 @@ const (
 )
 
 type GammaSDK struct {
-	baseURL    string
-	proxyUrl   *string
-	httpClient *http.Client
+	baseURL     string
+	proxyUrl    *string
+	httpClient  *http.Client
+	retryPolicy *retry.Policy
+	logger      *slog.Logger
 }
 
 func NewGammaSDK(proxyUrl *string) (*GammaSDK, error) {
@@ -30,9 +36,11 @@ func NewGammaSDK(proxyUrl *string) (*GammaSDK, error) {
 	}
 
 	client := &GammaSDK{
-		baseURL:    GammaAPIBase,
-		proxyUrl:   proxyUrl,
-		httpClient: httpClient,
+		baseURL:     GammaAPIBase,
+		proxyUrl:    proxyUrl,
+		httpClient:  httpClient,
+		retryPolicy: retry.DefaultPolicy(),
+		logger:      logging.New(nil),
 	}
 	if client.proxyUrl != nil && *client.proxyUrl != "" {
 		proxy, err := url.Parse(*proxyUrl)
@@ -50,6 +58,16 @@ func (g *GammaSDK) GetHttpClient() *http.Client {
 	return g.httpClient
 }
 
+// SetRetryPolicy replaces the retry policy of the requests; nil disables retries.
+func (g *GammaSDK) SetRetryPolicy(policy *retry.Policy) {
+	g.retryPolicy = policy
+}
+
+// SetLogger replaces the logger of the client; nil uses slog.Default(). Credentials are redacted.
+func (g *GammaSDK) SetLogger(logger *slog.Logger) {
+	g.logger = logging.New(logger)
+}
+
 func (g *GammaSDK) buildURL(endpoint string, query interface{}) (string, error) {
 	u, err := url.Parse(g.baseURL + endpoint)
 	if err != nil {
@@ -126,6 +144,26 @@ func (g *GammaSDK) makeRequest(method, endpoint string, query interface{}) (*API
 		return nil, fmt.Errorf("failed to build URL: %w", err)
 	}
 
+	var apiResp *APIResponse
+	err = g.retryPolicy.Do(context.Background(), method == http.MethodGet, func() error {
+		var err error
+		apiResp, err = g.sendRequest(method, fullURL)
+		if err != nil {
+			return err
+		}
+		if apiResp.Err != nil {
+			return apiResp.Err
+		}
+		return nil
+	})
+	// error responses are reported through extractResponseData
+	if err != nil && (apiResp == nil || apiResp.Err == nil) {
+		return nil, err
+	}
+	return apiResp, nil
+}
+
+func (g *GammaSDK) sendRequest(method, fullURL string) (*APIResponse, error) {
 	req, err := g.createRequest(method, fullURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
@@ -133,9 +171,11 @@ func (g *GammaSDK) makeRequest(method, endpoint string, query interface{}) (*API
 
 	resp, err := g.httpClient.Do(req)
 	if err != nil {
+		g.logger.Debug("gamma request failed", "method", method, "url", req.URL.Path, "error", err)
 		return nil, fmt.Errorf("failed to make request: %w", err)
 	}
 	defer resp.Body.Close()
+	g.logger.Debug("gamma request", "method", method, "url", req.URL.Path, "status", resp.StatusCode)
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -147,6 +187,10 @@ func (g *GammaSDK) makeRequest(method, endpoint string, query interface{}) (*API
 		OK:     resp.StatusCode >= 200 && resp.StatusCode < 300,
 	}
 
+	if !apiResp.OK {
+		apiResp.Err = types.NewAPIError(resp, body)
+	}
+
 	if resp.StatusCode == 204 {
 		return apiResp, nil
 	}
@@ -156,6 +200,9 @@ func (g *GammaSDK) makeRequest(method, endpoint string, query interface{}) (*API
 			var errData GammaError
 			if err := sonic.Unmarshal(body, &errData); err == nil {
 				apiResp.ErrorData = errData
+				if errData.Message != "" {
+					apiResp.Err.Message = errData.Message
+				}
 			} else {
 				apiResp.ErrorData = string(body)
 			}
@@ -169,6 +216,9 @@ func (g *GammaSDK) makeRequest(method, endpoint string, query interface{}) (*API
 
 func (g *GammaSDK) extractResponseData(resp *APIResponse, operation string) ([]byte, error) {
 	if !resp.OK {
+		if resp.Err != nil {
+			return nil, fmt.Errorf("[GammaSDK] %s failed: %w", operation, resp.Err)
+		}
 		return nil, fmt.Errorf("[GammaSDK] %s failed: status %d", operation, resp.Status)
 	}
 
@@ -369,7 +419,7 @@ func (g *GammaSDK) transformMarketData(item map[string]interface{}) Market {
 	itemBytes, _ := sonic.Marshal(item)
 	err := sonic.Unmarshal(itemBytes, &market)
 	if err != nil {
-		log.Printf("[GammaSDK] unmarshal Market failed: %v, raw=%s", err, string(itemBytes))
+		g.logger.Warn("unmarshal market failed", "error", err, "raw", string(itemBytes))
 		return Market{}
 	}
 
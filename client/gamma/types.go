@@ -3,6 +3,8 @@ package gamma
 import (
 	"encoding/json"
 	"time"
+
+	"github.com/ybina/polymarket-go/client/types"
 )
 
 type Team struct {
@@ -393,6 +395,7 @@ type APIResponse struct {
 	Status    int             `json:"status"`
 	OK        bool            `json:"ok"`
 	ErrorData interface{}     `json:"errorData,omitempty"`
+	Err       *types.APIError `json:"-"`
 }
 
 type GammaError struct {
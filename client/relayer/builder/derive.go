@@ -58,3 +58,22 @@ func Derive(addr common.Address, safeFactory common.Address) common.Address {
 
 	return safeAddr
 }
+
+// DeriveProxy returns the Polymarket proxy wallet of addr, deployed by proxyFactory with
+// CREATE2 and salt keccak256(abi.encodePacked(addr)).
+func DeriveProxy(addr common.Address, proxyFactory common.Address) common.Address {
+	if addr == constants.ZERO_ADDRESS {
+		return constants.ZERO_ADDRESS
+	}
+
+	proxyAddr, err := getCreate2Address(
+		constants.PROXY_INIT_CODE_HASH,
+		proxyFactory.Hex(),
+		crypto.Keccak256(addr.Bytes()),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return proxyAddr
+}
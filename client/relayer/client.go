@@ -8,7 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"math/big"
 	"net/http"
 	"net/url"
@@ -30,8 +30,10 @@ import (
 	"github.com/ybina/polymarket-go/client/constants"
 	"github.com/ybina/polymarket-go/client/relayer/builder"
 	"github.com/ybina/polymarket-go/client/relayer/model"
+	"github.com/ybina/polymarket-go/client/retry"
 	"github.com/ybina/polymarket-go/client/signer"
 	"github.com/ybina/polymarket-go/client/types"
+	"github.com/ybina/polymarket-go/tools/logging"
 )
 
 type RelayClient struct {
@@ -41,6 +43,11 @@ type RelayClient struct {
 	BuilderConfig  *headers.BuilderConfig
 	HttpClient     *http.Client
 	ContractConfig config.ContractConfig
+	// RetryPolicy is applied to relayer requests; nil uses retry.DefaultPolicy().
+	// Transactions are only resubmitted when the policy sets RetryNonIdempotent.
+	RetryPolicy *retry.Policy
+	// Logger receives the client logs; nil uses slog.Default(). Credentials are redacted.
+	Logger *slog.Logger
 }
 
 type RelayerTransaction struct {
@@ -88,24 +95,17 @@ func NewRelayClient(
 			Transport: transport,
 		},
 		ContractConfig: cfg,
+		RetryPolicy:    retry.DefaultPolicy(),
+		Logger:         logging.New(nil),
 	}, nil
 }
 
 func (c *RelayClient) GetNonce(address common.Address, signerType string) (uint64, error) {
 	reqUrl := fmt.Sprintf("%s%s?address=%s&type=%s", c.RelayerURL, GET_NONCE, address.Hex(), signerType)
 
-	resp, err := c.HttpClient.Get(reqUrl)
+	body, err := c.get(reqUrl)
 	if err != nil {
-		return 0, err
-	}
-	defer resp.Body.Close()
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return 0, err
-	}
-	if resp.StatusCode != http.StatusOK {
-
-		return 0, fmt.Errorf("get nonce http %d: %s", resp.StatusCode, string(body))
+		return 0, fmt.Errorf("get nonce: %w", err)
 	}
 
 	var out struct {
@@ -198,55 +198,65 @@ func (c *RelayClient) GetTransactions() ([]map[string]interface{}, error) {
 	return c.getJSONList(reqUrl)
 }
 
-func (c *RelayClient) getJSON(url string, out interface{}) error {
-	resp, err := c.HttpClient.Get(url)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
+func (c *RelayClient) logger() *slog.Logger {
+	return logging.New(c.Logger)
+}
 
-	if resp.StatusCode >= 400 {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("http %d: %s", resp.StatusCode, string(body))
+func (c *RelayClient) retryPolicy() *retry.Policy {
+	if c.RetryPolicy == nil {
+		return retry.DefaultPolicy()
 	}
-
-	return json.NewDecoder(resp.Body).Decode(out)
+	return c.RetryPolicy
 }
 
-func (c *RelayClient) getJSONList(url string) ([]map[string]interface{}, error) {
-	resp, err := c.HttpClient.Get(url)
+// get sends a GET request, retrying it according to the retry policy, and returns the body.
+func (c *RelayClient) get(url string) ([]byte, error) {
+	var body []byte
+	err := c.retryPolicy().Do(context.Background(), true, func() error {
+		resp, err := c.HttpClient.Get(url)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		body, err = io.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode != http.StatusOK {
+			return types.NewAPIError(resp, body)
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
+	return body, nil
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, errors.New("http error")
+func (c *RelayClient) getJSON(url string, out interface{}) error {
+	body, err := c.get(url)
+	if err != nil {
+		return err
 	}
+	return json.Unmarshal(body, out)
+}
 
+func (c *RelayClient) getJSONList(url string) ([]map[string]interface{}, error) {
 	var out []map[string]interface{}
-	return out, json.NewDecoder(resp.Body).Decode(&out)
+	if err := c.getJSON(url, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
 func (c *RelayClient) IsDeployed(safeAddr common.Address) (bool, error) {
 	reqUrl := fmt.Sprintf("%s%s?address=%s", c.RelayerURL, GET_DEPLOYED, safeAddr.Hex())
 
-	resp, err := c.HttpClient.Get(reqUrl)
-	if err != nil {
-		return false, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return false, fmt.Errorf("get deployed http %d: %s", resp.StatusCode, string(body))
-	}
-
 	var out struct {
 		Deployed bool `json:"deployed"`
 	}
-	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
-		return false, err
+	if err := c.getJSON(reqUrl, &out); err != nil {
+		return false, fmt.Errorf("get deployed: %w", err)
 	}
 	return out.Deployed, nil
 }
@@ -394,7 +404,7 @@ func (c *RelayClient) DeployWithTurnkey(turnkeyAccount common.Address) (common.A
 	}
 
 	safeAddr := builder.Derive(turnkeyAccount, c.ContractConfig.SafeFactory)
-	log.Printf("builder.Derive SafeAddr: %s\n", safeAddr)
+	c.logger().Debug("derived safe address", "safe", safeAddr.Hex())
 
 	args := model.SafeCreateTransactionArgs{
 		FromAddress:     turnkeyAccount,
@@ -438,46 +448,47 @@ func (c *RelayClient) submit(req *model.TransactionRequest) (*ClientRelayerTrans
 		return nil, err
 	}
 
-	httpReq, err := http.NewRequest(
-		"POST",
-		c.RelayerURL+SUBMIT_TRANSACTION,
-		bytes.NewReader(raw),
-	)
-	if err != nil {
-		return nil, err
-	}
+	var respBytes []byte
+	err = c.retryPolicy().Do(context.Background(), false, func() error {
+		httpReq, err := http.NewRequest(
+			"POST",
+			c.RelayerURL+SUBMIT_TRANSACTION,
+			bytes.NewReader(raw),
+		)
+		if err != nil {
+			return err
+		}
 
-	httpReq.Header.Set("Content-Type", "application/json")
+		httpReq.Header.Set("Content-Type", "application/json")
 
-	httpReq.Header.Set("POLY_BUILDER_API_KEY", builderHeaders.POLYBuilderAPIKey)
-	httpReq.Header.Set("POLY_BUILDER_TIMESTAMP", builderHeaders.POLYBuilderTimestamp)
-	httpReq.Header.Set("POLY_BUILDER_PASSPHRASE", builderHeaders.POLYBuilderPassphrase)
-	httpReq.Header.Set("POLY_BUILDER_SIGNATURE", builderHeaders.POLYBuilderSignature)
+		httpReq.Header.Set("POLY_BUILDER_API_KEY", builderHeaders.POLYBuilderAPIKey)
+		httpReq.Header.Set("POLY_BUILDER_TIMESTAMP", builderHeaders.POLYBuilderTimestamp)
+		httpReq.Header.Set("POLY_BUILDER_PASSPHRASE", builderHeaders.POLYBuilderPassphrase)
+		httpReq.Header.Set("POLY_BUILDER_SIGNATURE", builderHeaders.POLYBuilderSignature)
 
-	resp, err := c.HttpClient.Do(httpReq)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+		resp, err := c.HttpClient.Do(httpReq)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
 
-	if resp.StatusCode >= 400 {
-		b, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("relayer error %d: %s", resp.StatusCode, string(b))
-	}
-	respBytes, err := io.ReadAll(resp.Body)
+		respBytes, err = io.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode >= 400 {
+			return types.NewAPIError(resp, respBytes)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("relayer submit: %w", err)
 	}
 
 	if len(respBytes) > 0 {
-		var pretty bytes.Buffer
-		if err := json.Indent(&pretty, respBytes, "", "  "); err == nil {
-			//log.Printf("submit response (pretty):\n%s\n", pretty.String())
-		} else {
-			log.Printf("submit response (raw): %s\n", string(respBytes))
-		}
+		c.logger().Debug("relayer submit response", "body", string(respBytes))
 	} else {
-		log.Printf("submit response: <empty body>")
+		c.logger().Warn("relayer submit response has an empty body")
 	}
 
 	if len(respBytes) > 0 {
@@ -509,10 +520,10 @@ func (c *RelayClient) ApproveForPolymarketWithTurnkey(turnkeyAccount common.Addr
 	}
 	safe := builder.Derive(turnkeyAccount, c.ContractConfig.SafeFactory)
 	approved, usdcApprovals, tokenApprovals, err := c.CheckAllApprovals(safe)
-	fmt.Printf("approved:%v, usdcApprove:%v, tokenApprove:%v \n", approved, usdcApprovals, tokenApprovals)
 	if err != nil {
 		return nil, err
 	}
+	c.logger().Debug("safe approvals", "safe", safe.Hex(), "approved", approved, "usdc", usdcApprovals, "tokens", tokenApprovals)
 	if approved {
 		return nil, nil
 	}
@@ -0,0 +1,117 @@
+// Package retry implements the retry policy shared by the HTTP clients of this module.
+package retry
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand/v2"
+	"net"
+	"syscall"
+	"time"
+
+	"github.com/ybina/polymarket-go/client/types"
+)
+
+// Policy configures how failed requests are retried. Retries happen on rate limits,
+// 5xx answers and transient network errors, waiting an exponential backoff with
+// jitter or the Retry-After of the server when it is longer.
+//
+// Idempotent requests (GET, DELETE) are retried by every client. Requests that may
+// have side effects, such as posting orders, are only retried when RetryNonIdempotent
+// is set, since a request that timed out may still have been executed.
+type Policy struct {
+	// MaxAttempts is the total number of attempts, including the first one. Values below 2 disable retries.
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the backoff and the Retry-After wait.
+	MaxBackoff time.Duration
+	// Multiplier grows the backoff after every attempt.
+	Multiplier float64
+	// Jitter is the fraction of the backoff that is randomized, between 0 and 1.
+	Jitter float64
+	// RetryNonIdempotent opts in to retrying requests with side effects.
+	RetryNonIdempotent bool
+}
+
+// DefaultPolicy is used by the clients when no policy is configured.
+func DefaultPolicy() *Policy {
+	return &Policy{
+		MaxAttempts:    3,
+		InitialBackoff: 200 * time.Millisecond,
+		MaxBackoff:     5 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+	}
+}
+
+// NoRetry disables retries.
+func NoRetry() *Policy {
+	return &Policy{MaxAttempts: 1}
+}
+
+// Do calls fn until it succeeds, returns an error that is not retryable, the attempts
+// are exhausted or ctx is done. The last error of fn is returned. A nil policy calls fn once.
+func (p *Policy) Do(ctx context.Context, idempotent bool, fn func() error) error {
+	err := fn()
+	if p == nil || (!idempotent && !p.RetryNonIdempotent) {
+		return err
+	}
+	for attempt := 1; attempt < p.MaxAttempts && err != nil && Retryable(err); attempt++ {
+		timer := time.NewTimer(p.delay(attempt, err))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		err = fn()
+	}
+	return err
+}
+
+// delay returns the wait before the given retry, starting at 1.
+func (p *Policy) delay(retry int, err error) time.Duration {
+	mult := p.Multiplier
+	if mult < 1 {
+		mult = 1
+	}
+	d := float64(p.InitialBackoff) * math.Pow(mult, float64(retry-1))
+	if p.MaxBackoff > 0 && d > float64(p.MaxBackoff) {
+		d = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		jitter := math.Min(p.Jitter, 1)
+		d -= d * jitter * rand.Float64()
+	}
+	backoff := time.Duration(d)
+
+	if apiErr, ok := types.AsAPIError(err); ok && apiErr.RetryAfter > backoff {
+		backoff = apiErr.RetryAfter
+		if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+			backoff = p.MaxBackoff
+		}
+	}
+	return backoff
+}
+
+// Retryable reports whether err is worth retrying: retryable API errors
+// (429, 5xx) and transient network failures. Context errors are never retried.
+func Retryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if apiErr, ok := types.AsAPIError(err); ok {
+		return apiErr.Retryable()
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED)
+}
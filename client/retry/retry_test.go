@@ -0,0 +1,88 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ybina/polymarket-go/client/types"
+)
+
+func testPolicy() *Policy {
+	return &Policy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond, Multiplier: 2, Jitter: 0.5}
+}
+
+func TestPolicy_Do(t *testing.T) {
+	unavailable := &types.APIError{StatusCode: http.StatusServiceUnavailable}
+	badRequest := &types.APIError{StatusCode: http.StatusBadRequest}
+
+	cases := []struct {
+		name       string
+		idempotent bool
+		optIn      bool
+		errs       []error
+		calls      int
+		wantErr    error
+	}{
+		{"retries until success", true, false, []error{unavailable, unavailable, nil}, 3, nil},
+		{"gives up after max attempts", true, false, []error{unavailable, unavailable, unavailable, nil}, 3, unavailable},
+		{"does not retry client errors", true, false, []error{badRequest, nil}, 1, badRequest},
+		{"does not retry non idempotent requests", false, false, []error{unavailable, nil}, 1, unavailable},
+		{"retries non idempotent requests on opt in", false, true, []error{unavailable, nil}, 2, nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := testPolicy()
+			p.RetryNonIdempotent = tc.optIn
+			calls := 0
+			err := p.Do(context.Background(), tc.idempotent, func() error {
+				err := tc.errs[calls]
+				calls++
+				return err
+			})
+			if calls != tc.calls {
+				t.Fatalf("expected %d calls, got %d", tc.calls, calls)
+			}
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("expected %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestPolicy_RetryAfter(t *testing.T) {
+	p := testPolicy()
+	p.MaxBackoff = time.Minute
+	limited := &types.APIError{StatusCode: http.StatusTooManyRequests, RetryAfter: 2 * time.Second}
+	if d := p.delay(1, limited); d != 2*time.Second {
+		t.Fatalf("expected Retry-After to be honored, got %s", d)
+	}
+	p.MaxBackoff = 50 * time.Millisecond
+	if d := p.delay(1, limited); d != 50*time.Millisecond {
+		t.Fatalf("expected Retry-After to be capped, got %s", d)
+	}
+	if d := p.delay(10, errors.New("eof")); d > 50*time.Millisecond || d < 25*time.Millisecond {
+		t.Fatalf("unexpected backoff %s", d)
+	}
+}
+
+func TestPolicy_DoCanceled(t *testing.T) {
+	p := testPolicy()
+	p.InitialBackoff = time.Hour
+	p.MaxBackoff = time.Hour
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	err := p.Do(ctx, true, func() error {
+		calls++
+		return &types.APIError{StatusCode: http.StatusBadGateway}
+	})
+	if err == nil || calls != 1 {
+		t.Fatalf("expected a single attempt, got %d calls and %v", calls, err)
+	}
+}
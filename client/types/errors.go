@@ -0,0 +1,178 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrorKind is a coarse classification of an APIError callers can branch on.
+type ErrorKind string
+
+const (
+	ErrorKindUnknown             ErrorKind = "unknown"
+	ErrorKindBadRequest          ErrorKind = "bad_request"
+	ErrorKindUnauthorized        ErrorKind = "unauthorized"
+	ErrorKindGeoblocked          ErrorKind = "geoblocked"
+	ErrorKindNotFound            ErrorKind = "not_found"
+	ErrorKindOrderNotFound       ErrorKind = "order_not_found"
+	ErrorKindInsufficientBalance ErrorKind = "insufficient_balance"
+	ErrorKindRateLimited         ErrorKind = "rate_limited"
+	ErrorKindServer              ErrorKind = "server"
+)
+
+// requestIDHeaders are checked in order for an identifier of the failed request.
+var requestIDHeaders = []string{"X-Request-Id", "X-Amzn-Requestid", "X-Amz-Cf-Id", "Cf-Ray"}
+
+// APIError is returned by every HTTP client of this module when a server answers with
+// an error status. Use errors.As to get it out of a wrapped error.
+type APIError struct {
+	StatusCode int
+	Method     string
+	Endpoint   string
+	// Message is the error reported by the server, or the raw body when it could not be parsed.
+	Message    string
+	RequestID  string
+	RetryAfter time.Duration
+	Body       []byte
+}
+
+// NewAPIError builds an APIError from a failed response whose body was already read.
+func NewAPIError(resp *http.Response, body []byte) *APIError {
+	e := &APIError{
+		StatusCode: resp.StatusCode,
+		Message:    errorMessage(body),
+		Body:       body,
+	}
+	if resp.Request != nil {
+		e.Method = resp.Request.Method
+		if resp.Request.URL != nil {
+			e.Endpoint = resp.Request.URL.Path
+		}
+	}
+	for _, h := range requestIDHeaders {
+		if v := resp.Header.Get(h); v != "" {
+			e.RequestID = v
+			break
+		}
+	}
+	e.RetryAfter = parseRetryAfter(resp.Header.Get("Retry-After"))
+	return e
+}
+
+func (e *APIError) Error() string {
+	var sb strings.Builder
+	sb.WriteString("HTTP ")
+	sb.WriteString(strconv.Itoa(e.StatusCode))
+	if e.Method != "" || e.Endpoint != "" {
+		sb.WriteString(" ")
+		sb.WriteString(strings.TrimSpace(e.Method + " " + e.Endpoint))
+	}
+	if e.Message != "" {
+		sb.WriteString(": ")
+		sb.WriteString(e.Message)
+	}
+	if e.RequestID != "" {
+		sb.WriteString(" (request id ")
+		sb.WriteString(e.RequestID)
+		sb.WriteString(")")
+	}
+	return sb.String()
+}
+
+// Kind classifies the error by status code and server message.
+func (e *APIError) Kind() ErrorKind {
+	msg := strings.ToLower(e.Message)
+	switch {
+	case e.StatusCode == http.StatusTooManyRequests:
+		return ErrorKindRateLimited
+	case strings.Contains(msg, "not enough balance"), strings.Contains(msg, "insufficient balance"):
+		return ErrorKindInsufficientBalance
+	case e.StatusCode == http.StatusForbidden && (strings.Contains(msg, "region") || strings.Contains(msg, "geoblock") || strings.Contains(msg, "restricted")):
+		return ErrorKindGeoblocked
+	case strings.Contains(msg, "order") && strings.Contains(msg, "not found"):
+		return ErrorKindOrderNotFound
+	case e.StatusCode == http.StatusNotFound, strings.Contains(msg, "could not derive api key"):
+		return ErrorKindNotFound
+	case e.StatusCode == http.StatusUnauthorized, e.StatusCode == http.StatusForbidden:
+		return ErrorKindUnauthorized
+	case e.StatusCode >= 500:
+		return ErrorKindServer
+	case e.StatusCode >= 400:
+		return ErrorKindBadRequest
+	}
+	return ErrorKindUnknown
+}
+
+// Retryable reports whether the same request may succeed when sent again later.
+func (e *APIError) Retryable() bool {
+	switch e.StatusCode {
+	case http.StatusRequestTimeout, http.StatusTooEarly, http.StatusTooManyRequests,
+		http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+func (e *APIError) IsRateLimited() bool         { return e.Kind() == ErrorKindRateLimited }
+func (e *APIError) IsGeoblocked() bool          { return e.Kind() == ErrorKindGeoblocked }
+func (e *APIError) IsOrderNotFound() bool       { return e.Kind() == ErrorKindOrderNotFound }
+func (e *APIError) IsInsufficientBalance() bool { return e.Kind() == ErrorKindInsufficientBalance }
+
+// AsAPIError unwraps err to an *APIError.
+func AsAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	return nil, false
+}
+
+// IsRetryable reports whether err wraps a retryable APIError.
+func IsRetryable(err error) bool {
+	apiErr, ok := AsAPIError(err)
+	return ok && apiErr.Retryable()
+}
+
+// errorMessage extracts the error message of the JSON bodies returned by the
+// CLOB ({"error"}), gamma and data ({"message"}, {"error"}) and relayer APIs.
+func errorMessage(body []byte) string {
+	var payload map[string]json.RawMessage
+	if err := json.Unmarshal(body, &payload); err == nil {
+		for _, key := range []string{"error", "errorMsg", "message", "msg"} {
+			raw, ok := payload[key]
+			if !ok {
+				continue
+			}
+			var s string
+			if err := json.Unmarshal(raw, &s); err == nil {
+				if s = strings.TrimSpace(s); s != "" {
+					return s
+				}
+				continue
+			}
+			if msg := errorMessage(raw); msg != "" {
+				return msg
+			}
+		}
+	}
+	return strings.TrimSpace(string(body))
+}
+
+func parseRetryAfter(v string) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs > 0 {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestNewAPIError(t *testing.T) {
+	cases := []struct {
+		status    int
+		body      string
+		message   string
+		kind      ErrorKind
+		retryable bool
+	}{
+		{400, `{"error":"not enough balance / allowance"}`, "not enough balance / allowance", ErrorKindInsufficientBalance, false},
+		{400, `{"error":"order 0xabc not found"}`, "order 0xabc not found", ErrorKindOrderNotFound, false},
+		{403, `{"error":"Trading restricted in your region"}`, "Trading restricted in your region", ErrorKindGeoblocked, false},
+		{404, `{"message":"event not found","code":404}`, "event not found", ErrorKindNotFound, false},
+		{400, `{"error":"Could not derive api key!"}`, "Could not derive api key!", ErrorKindNotFound, false},
+		{429, `Too Many Requests`, "Too Many Requests", ErrorKindRateLimited, true},
+		{503, ``, "", ErrorKindServer, true},
+		{401, `{"error":{"message":"invalid api key"}}`, "invalid api key", ErrorKindUnauthorized, false},
+	}
+	for _, tc := range cases {
+		resp := &http.Response{
+			StatusCode: tc.status,
+			Header:     http.Header{},
+			Request:    &http.Request{Method: "POST", URL: &url.URL{Path: "/order"}},
+		}
+		apiErr := NewAPIError(resp, []byte(tc.body))
+		if apiErr.Message != tc.message {
+			t.Errorf("%d %s: message %q", tc.status, tc.body, apiErr.Message)
+		}
+		if apiErr.Kind() != tc.kind {
+			t.Errorf("%d %s: kind %s, want %s", tc.status, tc.body, apiErr.Kind(), tc.kind)
+		}
+		if apiErr.Retryable() != tc.retryable {
+			t.Errorf("%d %s: retryable %v", tc.status, tc.body, apiErr.Retryable())
+		}
+	}
+}
+
+func TestAsAPIError(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: 429,
+		Header:     http.Header{"X-Request-Id": {"req-1"}, "Retry-After": {"3"}},
+		Request:    &http.Request{Method: "GET", URL: &url.URL{Path: "/book", RawQuery: "token_id=1"}},
+	}
+	err := fmt.Errorf("failed to get order book: %w", NewAPIError(resp, []byte(`{"error":"slow down"}`)))
+
+	apiErr, ok := AsAPIError(err)
+	if !ok {
+		t.Fatal("expected an APIError")
+	}
+	if apiErr.RequestID != "req-1" || apiErr.RetryAfter != 3*time.Second || !apiErr.IsRateLimited() {
+		t.Fatalf("unexpected error: %+v", apiErr)
+	}
+	if got := apiErr.Error(); got != "HTTP 429 GET /book: slow down (request id req-1)" {
+		t.Fatalf("unexpected message: %s", got)
+	}
+	if !IsRetryable(err) || IsRetryable(fmt.Errorf("plain")) {
+		t.Fatal("unexpected retryable classification")
+	}
+}
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -53,6 +56,11 @@ type ApiKeyCreds struct {
 	Passphrase string `json:"passphrase"`
 }
 
+// LogValue keeps credentials out of logs.
+func (c ApiKeyCreds) LogValue() slog.Value {
+	return slog.StringValue("[REDACTED]")
+}
+
 type ApiKeyRaw struct {
 	APIKey     string `json:"apiKey"`
 	Secret     string `json:"secret"`
@@ -73,6 +81,15 @@ type L1PolyHeader struct {
 	POLYNonce     string `json:"POLY_NONCE"`
 }
 
+// LogValue logs the headers without the signature.
+func (h L1PolyHeader) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("POLY_ADDRESS", h.POLYAddress),
+		slog.String("POLY_TIMESTAMP", h.POLYTimestamp),
+		slog.String("POLY_NONCE", h.POLYNonce),
+	)
+}
+
 type L2PolyHeader struct {
 	POLYAddress    string `json:"POLY_ADDRESS"`
 	POLYSignature  string `json:"POLY_SIGNATURE"`
@@ -81,6 +98,14 @@ type L2PolyHeader struct {
 	POLYPassphrase string `json:"POLY_PASSPHRASE"`
 }
 
+// LogValue logs the headers without the credentials and signature.
+func (h L2PolyHeader) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("POLY_ADDRESS", h.POLYAddress),
+		slog.String("POLY_TIMESTAMP", h.POLYTimestamp),
+	)
+}
+
 func (h *L2PolyHeader) ToMap() map[string]string {
 	return map[string]string{
 		"POLY_ADDRESS":    h.POLYAddress,
@@ -171,6 +196,12 @@ type OrderResponse struct {
 	MakingAmount       string   `json:"makingAmount"`
 }
 
+// CancelOrdersResponse is returned by the cancel endpoints. NotCanceled maps order id to the reason it was not canceled.
+type CancelOrdersResponse struct {
+	Canceled    []string          `json:"canceled"`
+	NotCanceled map[string]string `json:"not_canceled"`
+}
+
 type OpenOrder struct {
 	ID              string   `json:"id"`
 	Status          string   `json:"status"`
@@ -249,6 +280,71 @@ type MarketPrice struct {
 	P float64 `json:"p"`
 }
 
+type PricesHistory struct {
+	History []MarketPrice `json:"history"`
+}
+
+type LastTradePrice struct {
+	TokenID string          `json:"token_id,omitempty"`
+	Price   decimal.Decimal `json:"price"`
+	Side    Side            `json:"side"`
+}
+
+// TokenPrices holds the best price of a token per side, as returned by /prices.
+type TokenPrices map[Side]decimal.Decimal
+
+type MarketToken struct {
+	TokenID string          `json:"token_id"`
+	Outcome string          `json:"outcome"`
+	Price   decimal.Decimal `json:"price"`
+	Winner  bool            `json:"winner"`
+}
+
+type MarketRewards struct {
+	Rates     []MarketRewardRate `json:"rates"`
+	MinSize   decimal.Decimal    `json:"min_size"`
+	MaxSpread decimal.Decimal    `json:"max_spread"`
+}
+
+type MarketRewardRate struct {
+	AssetAddress     string          `json:"asset_address"`
+	RewardsDailyRate decimal.Decimal `json:"rewards_daily_rate"`
+}
+
+// Market is a CLOB market as returned by /markets/{condition_id}.
+type Market struct {
+	ConditionID             string          `json:"condition_id"`
+	QuestionID              string          `json:"question_id"`
+	Question                string          `json:"question"`
+	Description             string          `json:"description"`
+	MarketSlug              string          `json:"market_slug"`
+	Category                string          `json:"category"`
+	EndDateISO              string          `json:"end_date_iso"`
+	GameStartTime           string          `json:"game_start_time"`
+	Icon                    string          `json:"icon"`
+	Image                   string          `json:"image"`
+	Fpmm                    string          `json:"fpmm"`
+	Tokens                  []MarketToken   `json:"tokens"`
+	Rewards                 MarketRewards   `json:"rewards"`
+	Tags                    []string        `json:"tags"`
+	MinimumOrderSize        decimal.Decimal `json:"minimum_order_size"`
+	MinimumTickSize         decimal.Decimal `json:"minimum_tick_size"`
+	MakerBaseFee            int             `json:"maker_base_fee"`
+	TakerBaseFee            int             `json:"taker_base_fee"`
+	SecondsDelay            int             `json:"seconds_delay"`
+	Active                  bool            `json:"active"`
+	Closed                  bool            `json:"closed"`
+	Archived                bool            `json:"archived"`
+	AcceptingOrders         bool            `json:"accepting_orders"`
+	AcceptingOrderTimestamp string          `json:"accepting_order_timestamp"`
+	EnableOrderBook         bool            `json:"enable_order_book"`
+	NotificationsEnabled    bool            `json:"notifications_enabled"`
+	NegRisk                 bool            `json:"neg_risk"`
+	NegRiskMarketID         string          `json:"neg_risk_market_id"`
+	NegRiskRequestID        string          `json:"neg_risk_request_id"`
+	Is5050Outcome           bool            `json:"is_50_50_outcome"`
+}
+
 type PriceHistoryFilterParams struct {
 	Market   *string               `json:"market,omitempty"`
 	StartTs  *int64                `json:"startTs,omitempty"`
@@ -271,10 +367,74 @@ type DropNotificationParams struct {
 	IDs []string `json:"ids"`
 }
 
+type NotificationType int
+
+const (
+	NotificationTypeOrderCancellation NotificationType = 1
+	NotificationTypeOrderFill         NotificationType = 2
+	NotificationTypeMarketResolved    NotificationType = 4
+)
+
 type Notification struct {
-	Type    int         `json:"type"`
-	Owner   string      `json:"owner"`
-	Payload interface{} `json:"payload"`
+	ID      int64            `json:"id"`
+	Type    NotificationType `json:"type"`
+	Owner   string           `json:"owner"`
+	Payload json.RawMessage  `json:"payload"`
+}
+
+// OrderNotificationPayload is the payload of order cancellation and order fill notifications.
+type OrderNotificationPayload struct {
+	OrderID         string `json:"order_id"`
+	TradeID         string `json:"trade_id,omitempty"`
+	AssetID         string `json:"asset_id"`
+	ConditionID     string `json:"condition_id"`
+	MarketSlug      string `json:"market_slug"`
+	EventSlug       string `json:"eventSlug"`
+	Question        string `json:"question"`
+	Icon            string `json:"icon"`
+	Outcome         string `json:"outcome"`
+	OutcomeIndex    int    `json:"outcome_index"`
+	Side            Side   `json:"side"`
+	Price           string `json:"price"`
+	OriginalSize    string `json:"original_size"`
+	MatchedSize     string `json:"matched_size"`
+	RemainingSize   string `json:"remaining_size"`
+	TransactionHash string `json:"transaction_hash,omitempty"`
+	Owner           string `json:"owner"`
+}
+
+// MarketResolvedPayload is the payload of market resolution notifications.
+type MarketResolvedPayload struct {
+	ConditionID    string `json:"condition_id"`
+	MarketSlug     string `json:"market_slug"`
+	EventSlug      string `json:"eventSlug"`
+	Question       string `json:"question"`
+	Icon           string `json:"icon"`
+	WinningOutcome string `json:"winning_outcome"`
+}
+
+// OrderPayload decodes the payload of an order cancellation or order fill notification.
+func (n *Notification) OrderPayload() (*OrderNotificationPayload, error) {
+	if n.Type != NotificationTypeOrderCancellation && n.Type != NotificationTypeOrderFill {
+		return nil, fmt.Errorf("notification type %d has no order payload", n.Type)
+	}
+	var p OrderNotificationPayload
+	if err := json.Unmarshal(n.Payload, &p); err != nil {
+		return nil, fmt.Errorf("failed to decode order notification payload: %w", err)
+	}
+	return &p, nil
+}
+
+// MarketResolvedPayload decodes the payload of a market resolution notification.
+func (n *Notification) MarketResolvedPayload() (*MarketResolvedPayload, error) {
+	if n.Type != NotificationTypeMarketResolved {
+		return nil, fmt.Errorf("notification type %d has no market resolved payload", n.Type)
+	}
+	var p MarketResolvedPayload
+	if err := json.Unmarshal(n.Payload, &p); err != nil {
+		return nil, fmt.Errorf("failed to decode market resolved payload: %w", err)
+	}
+	return &p, nil
 }
 
 type OrderMarketCancelParams struct {
@@ -312,8 +472,17 @@ type BalanceAllowanceParams struct {
 }
 
 type BalanceAllowanceResponse struct {
-	Balance   string `json:"balance"`
-	Allowance string `json:"allowance"`
+	Balance    string            `json:"balance"`
+	Allowance  string            `json:"allowance"`
+	Allowances map[string]string `json:"allowances,omitempty"`
+}
+
+// BalanceAllowance is a BalanceAllowanceResponse scaled from 6-decimal base units.
+// Allowances is keyed by spender address when the exchange reports one per contract.
+type BalanceAllowance struct {
+	Balance    decimal.Decimal
+	Allowance  decimal.Decimal
+	Allowances map[string]decimal.Decimal
 }
 
 type OrderScoringParams struct {
@@ -372,6 +541,31 @@ type NegRisk map[string]bool
 
 type FeeRates map[string]int
 
+// SimplifiedMarket is the reduced market returned by /simplified-markets and /sampling-simplified-markets.
+type SimplifiedMarket struct {
+	ConditionID     string        `json:"condition_id"`
+	Tokens          []MarketToken `json:"tokens"`
+	Rewards         MarketRewards `json:"rewards"`
+	Active          bool          `json:"active"`
+	Closed          bool          `json:"closed"`
+	Archived        bool          `json:"archived"`
+	AcceptingOrders bool          `json:"accepting_orders"`
+}
+
+type MarketsPage struct {
+	Limit      int      `json:"limit"`
+	Count      int      `json:"count"`
+	NextCursor string   `json:"next_cursor"`
+	Data       []Market `json:"data"`
+}
+
+type SimplifiedMarketsPage struct {
+	Limit      int                `json:"limit"`
+	Count      int                `json:"count"`
+	NextCursor string             `json:"next_cursor"`
+	Data       []SimplifiedMarket `json:"data"`
+}
+
 type PaginationPayload struct {
 	Limit      int         `json:"limit"`
 	Count      int         `json:"count"`
@@ -477,6 +671,36 @@ type UserRewardsEarning struct {
 	Earnings              []Earning       `json:"earnings"`
 }
 
+// UserRewardsMarketsParams filters and sorts GetUserEarningsAndMarketsConfig.
+type UserRewardsMarketsParams struct {
+	OrderBy       string `json:"order_by,omitempty"`
+	Position      string `json:"position,omitempty"`
+	NoCompetition bool   `json:"no_competition,omitempty"`
+}
+
+type BuilderTradeParams struct {
+	ID      *string `json:"id,omitempty"`
+	Market  *string `json:"market,omitempty"`
+	AssetID *string `json:"asset_id,omitempty"`
+	Before  *string `json:"before,omitempty"`
+	After   *string `json:"after,omitempty"`
+}
+
+// BuilderTradesSummary aggregates builder trades; failed trades are only counted in Failed.
+type BuilderTradesSummary struct {
+	Trades   int
+	Failed   int
+	Volume   decimal.Decimal
+	Fees     decimal.Decimal
+	ByMarket map[string]*BuilderMarketSummary
+}
+
+type BuilderMarketSummary struct {
+	Trades int
+	Volume decimal.Decimal
+	Fees   decimal.Decimal
+}
+
 type BuilderTrade struct {
 	ID              string     `json:"id"`
 	TradeType       string     `json:"tradeType"`
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"sync"
@@ -17,6 +18,7 @@ import (
 	"github.com/ybina/polymarket-go/client/config"
 	"github.com/ybina/polymarket-go/client/endpoint"
 	"github.com/ybina/polymarket-go/client/types"
+	"github.com/ybina/polymarket-go/tools/logging"
 )
 
 type WebSocketClientOptions struct {
@@ -32,9 +34,31 @@ type WebSocketClientOptions struct {
 
 	Debug bool
 
+	// SLogger receives the client logs; nil uses Logger, then slog.Default(). Credentials
+	// are redacted.
+	SLogger *slog.Logger
+
+	// Logger receives the client logs as text lines, debug logs only with Debug set.
+	//
+	// Deprecated: use SLogger.
 	Logger *log.Logger
 
 	ProxyUrl string
+
+	// UpdateTickSizeCache pushes tick_size_change messages into the clob client's metadata cache.
+	UpdateTickSizeCache bool
+}
+
+// slogger is SLogger, or Logger adapted to slog.
+func (o WebSocketClientOptions) slogger() *slog.Logger {
+	if o.SLogger != nil || o.Logger == nil {
+		return o.SLogger
+	}
+	level := slog.LevelInfo
+	if o.Debug {
+		level = slog.LevelDebug
+	}
+	return logging.FromLogLogger(o.Logger, level)
 }
 
 // MessageHandler is a callback function for handling messages
@@ -80,7 +104,7 @@ type WebSocketClient struct {
 	reconnectAttempts int
 	isConnecting      bool
 	shouldReconnect   bool
-	logger            *log.Logger
+	logger            *slog.Logger
 }
 
 func NewWebSocketClient(clobClient *clob.ClobClient, options *WebSocketClientOptions) *WebSocketClient {
@@ -92,18 +116,13 @@ func NewWebSocketClient(clobClient *clob.ClobClient, options *WebSocketClientOpt
 		options.ReconnectDelay = 5 * time.Second
 	}
 
-	logger := options.Logger
-	if logger == nil {
-		logger = log.Default()
-	}
-
 	return &WebSocketClient{
 		clobClient:      clobClient,
 		options:         options,
 		callbacks:       &WebSocketCallbacks{},
 		done:            nil,
 		shouldReconnect: true,
-		logger:          logger,
+		logger:          logging.New(options.slogger()),
 	}
 }
 
@@ -116,25 +135,28 @@ func (ws *WebSocketClient) Connect() error {
 	ws.mu.Lock()
 	if ws.isConnecting || (ws.conn != nil && ws.IsConnected()) {
 		ws.mu.Unlock()
-		log.Printf("Already connected or connecting")
+		ws.logger.Debug("already connected or connecting")
 		return nil
 	}
 	ws.isConnecting = true
 	ws.shouldReconnect = true
 	ws.mu.Unlock()
 
-	option := clob_types.ClobOption{
-		TurnkeyAccount: common.Address{},
-		SafeAccount:    common.Address{},
-	}
-	apiKey, err := ws.clobClient.DeriveApiKey(nil, option)
-	if err != nil {
-		ws.mu.Lock()
-		ws.isConnecting = false
-		ws.mu.Unlock()
-		return fmt.Errorf("failed to derive API key: %w", err)
+	// credentials are kept by the clob client, only derive them on the first connect
+	if !ws.clobClient.HasApiCreds() {
+		option := clob_types.ClobOption{
+			TurnkeyAccount: common.Address{},
+			SafeAccount:    common.Address{},
+		}
+		_, err := ws.clobClient.CreateOrDeriveApiKey(nil, option)
+		if err != nil {
+			ws.mu.Lock()
+			ws.isConnecting = false
+			ws.mu.Unlock()
+			return fmt.Errorf("failed to derive API key: %w", err)
+		}
+		ws.logger.Info("API key derived")
 	}
-	log.Printf("API key derived:%v\n", apiKey.Key)
 
 	fullURL := fmt.Sprintf("%s/ws/market", endpoint.WsUrl)
 	tlsConfig := &tls.Config{
@@ -171,18 +193,18 @@ func (ws *WebSocketClient) Connect() error {
 	ws.mu.Unlock()
 
 	conn.SetPongHandler(func(appData string) error {
-		log.Printf("Received CONTROL PONG: %s\n", appData)
+		ws.logger.Debug("received control pong", "data", appData)
 		return nil
 	})
 	conn.SetPingHandler(func(appData string) error {
-		log.Printf("Received CONTROL PING: %s\n", appData)
+		ws.logger.Debug("received control ping", "data", appData)
 		_ = ws.withConnWrite(func(c *websocket.Conn) error {
 			return c.WriteControl(websocket.PongMessage, []byte(appData), time.Now().Add(5*time.Second))
 		})
 		return nil
 	})
 
-	log.Printf("WebSocket connected \n")
+	ws.logger.Info("websocket connected", "url", fullURL)
 
 	if err = ws.sendInitialSubscription(); err != nil {
 		ws.forceCloseWithReason(-1, fmt.Sprintf("send subscription failed: %v", err))
@@ -297,7 +319,7 @@ func (ws *WebSocketClient) sendInitialSubscription() error {
 		"assets_ids": assetIDs,
 		"type":       "market",
 	}
-	log.Printf("subscribe request: %v\n", message)
+	ws.logger.Debug("subscribe request", "assets_ids", assetIDs)
 	return ws.withConnWrite(func(conn *websocket.Conn) error {
 		return conn.WriteJSON(message)
 	})
@@ -317,7 +339,7 @@ func (ws *WebSocketClient) sendSubscription(tokenIds []string) error {
 		"operation":  "subscribe",
 	}
 
-	log.Printf("subscribe request: %v\n", message)
+	ws.logger.Debug("subscribe request", "assets_ids", tokenIds)
 	return ws.withConnWrite(func(conn *websocket.Conn) error {
 		return conn.WriteJSON(message)
 	})
@@ -325,7 +347,7 @@ func (ws *WebSocketClient) sendSubscription(tokenIds []string) error {
 
 func (ws *WebSocketClient) handleMessages() {
 	defer func() {
-		log.Printf("Message handler stopped \n")
+		ws.logger.Debug("message handler stopped")
 	}()
 
 	for {
@@ -347,10 +369,10 @@ func (ws *WebSocketClient) handleMessages() {
 		messageType, message, err := conn.ReadMessage()
 		if err != nil {
 			if ce, ok := err.(*websocket.CloseError); ok {
-				log.Printf("ReadMessage CloseError: code= %v, reason= %v\n", ce.Code, ce.Text)
+				ws.logger.Warn("websocket closed", "code", ce.Code, "reason", ce.Text)
 				ws.handleDisconnect(ce.Code, ce.Text)
 			} else {
-				log.Printf("ReadMessage error: %v\n", err)
+				ws.logger.Warn("websocket read failed", "error", err)
 				ws.handleDisconnect(-1, err.Error())
 			}
 			return
@@ -360,11 +382,11 @@ func (ws *WebSocketClient) handleMessages() {
 			txt := string(message)
 
 			if txt == "PONG" {
-				log.Printf("Received TEXT PONG \n")
+				ws.logger.Debug("received text pong")
 				continue
 			}
 			if txt == "ping" || txt == "PING" {
-				log.Printf("Received TEXT PING, reply TEXT PONG \n")
+				ws.logger.Debug("received text ping")
 				_ = ws.withConnWrite(func(c *websocket.Conn) error {
 					reply := "pong"
 					if txt == "PING" {
@@ -394,7 +416,7 @@ func (ws *WebSocketClient) parseAndDispatch(data []byte) {
 	msg, err := types.ParseMarketChannelMessage(data)
 	if err != nil {
 		ws.handleError(fmt.Errorf("failed to parse message: %w", err))
-		log.Printf("Raw message: %s", string(data))
+		ws.logger.Debug("unparsed message", "raw", string(data))
 		return
 	}
 
@@ -409,7 +431,11 @@ func (ws *WebSocketClient) parseAndDispatch(data []byte) {
 			ws.callbacks.OnPriceChange(pcMsg)
 		}
 	case types.EventTypeTickSizeChange:
-		if tsMsg, ok := types.AsTickSizeChangeMessage(msg); ok && ws.callbacks.OnTickSizeChange != nil {
+		tsMsg, ok := types.AsTickSizeChangeMessage(msg)
+		if ok && ws.options.UpdateTickSizeCache {
+			ws.clobClient.SetTickSize(tsMsg.AssetID, types.TickSize(tsMsg.NewTickSize))
+		}
+		if ok && ws.callbacks.OnTickSizeChange != nil {
 			ws.callbacks.OnTickSizeChange(tsMsg)
 		}
 	case types.EventTypeLastTradePrice:
@@ -443,11 +469,11 @@ func (ws *WebSocketClient) pingWorker() {
 				return conn.WriteMessage(websocket.TextMessage, []byte("PING"))
 			})
 			if err != nil {
-				log.Printf("failed to send ping: %v\n", err.Error())
+				ws.logger.Warn("failed to send ping", "error", err)
 				ws.handleDisconnect(-1, "ping send failed: "+err.Error())
 				return
 			}
-			log.Printf("Sent TEXT PING \n")
+			ws.logger.Debug("sent text ping")
 		}
 	}
 }
@@ -456,7 +482,7 @@ func (ws *WebSocketClient) handleError(err error) {
 	if ws.callbacks.OnError != nil {
 		ws.callbacks.OnError(err)
 	} else {
-		log.Printf("Error: %v\n", err)
+		ws.logger.Error("websocket error", "error", err)
 	}
 }
 
@@ -495,7 +521,7 @@ func (ws *WebSocketClient) tryReconnect() {
 	ws.mu.Lock()
 	if ws.options.MaxReconnectAttempts > 0 && ws.reconnectAttempts >= ws.options.MaxReconnectAttempts {
 		ws.mu.Unlock()
-		log.Println("Max reconnect attempts reached")
+		ws.logger.Error("max reconnect attempts reached", "attempts", ws.options.MaxReconnectAttempts)
 		return
 	}
 
@@ -507,7 +533,7 @@ func (ws *WebSocketClient) tryReconnect() {
 	}
 	ws.mu.Unlock()
 
-	log.Printf(fmt.Sprintf("Scheduling reconnect attempt %d after %s...\n", attempt, delay))
+	ws.logger.Info("scheduling reconnect", "attempt", attempt, "delay", delay)
 
 	if ws.callbacks.OnReconnect != nil {
 		ws.callbacks.OnReconnect(attempt)
@@ -519,9 +545,9 @@ func (ws *WebSocketClient) tryReconnect() {
 		ws.reconnectTimer = nil
 		ws.mu.Unlock()
 
-		log.Printf(fmt.Sprintf("Attempting reconnect %d... \n", attempt))
+		ws.logger.Info("reconnecting", "attempt", attempt)
 		if err := ws.Connect(); err != nil {
-			log.Printf("Reconnect failed: %v\n", err)
+			ws.logger.Warn("reconnect failed", "attempt", attempt, "error", err)
 			ws.handleDisconnect(-1, "reconnect failed: "+err.Error())
 		}
 	})
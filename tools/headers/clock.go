@@ -0,0 +1,110 @@
+package headers
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultClockSyncInterval is how often ClockSync measures the server clock.
+const DefaultClockSyncInterval = time.Minute
+
+// ServerTimeFunc returns the server time in unix seconds.
+type ServerTimeFunc func(ctx context.Context) (int64, error)
+
+// ClockSync tracks the offset between the local clock and the server clock so
+// that header timestamps can follow server time without a request per signature.
+// Until a measurement succeeds, the local clock is used.
+type ClockSync struct {
+	fetch    ServerTimeFunc
+	interval time.Duration
+
+	syncMu sync.Mutex
+
+	mu          sync.RWMutex
+	offset      time.Duration
+	synced      bool
+	lastAttempt time.Time
+	lastErr     error
+}
+
+func NewClockSync(fetch ServerTimeFunc, interval time.Duration) *ClockSync {
+	if interval <= 0 {
+		interval = DefaultClockSyncInterval
+	}
+	return &ClockSync{fetch: fetch, interval: interval}
+}
+
+// Sync measures the clock offset once. The server time is compared to the local
+// time halfway through the request to compensate for the round trip; since the
+// server reports whole seconds, its time is taken as the middle of that second.
+func (s *ClockSync) Sync(ctx context.Context) error {
+	start := time.Now()
+	serverTime, err := s.fetch(ctx)
+	end := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastAttempt = end
+	s.lastErr = err
+	if err != nil {
+		return err
+	}
+	local := start.Add(end.Sub(start) / 2)
+	server := time.Unix(serverTime, int64(500*time.Millisecond))
+	s.offset = server.Sub(local)
+	s.synced = true
+	return nil
+}
+
+// Start syncs the clock now and then every interval until ctx is done.
+func (s *ClockSync) Start(ctx context.Context) {
+	s.trySync(ctx)
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.trySync(ctx)
+			}
+		}
+	}()
+}
+
+// Now returns the estimated server time. When no measurement was attempted within
+// the interval, one is made first; concurrent callers do not wait for it. If it
+// fails, the last known offset (or none) is applied to the local clock.
+func (s *ClockSync) Now(ctx context.Context) time.Time {
+	s.mu.RLock()
+	stale := time.Since(s.lastAttempt) >= s.interval
+	s.mu.RUnlock()
+	if stale {
+		s.trySync(ctx)
+	}
+	return time.Now().Add(s.Offset())
+}
+
+func (s *ClockSync) trySync(ctx context.Context) {
+	if !s.syncMu.TryLock() {
+		return
+	}
+	defer s.syncMu.Unlock()
+	_ = s.Sync(ctx)
+}
+
+// Offset is the server clock minus the local clock, zero until a sync succeeds.
+func (s *ClockSync) Offset() time.Duration {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.offset
+}
+
+// Synced reports whether a measurement succeeded, and the error of the last attempt.
+func (s *ClockSync) Synced() (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.synced, s.lastErr
+}
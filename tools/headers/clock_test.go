@@ -0,0 +1,53 @@
+package headers
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClockSync(t *testing.T) {
+	var calls atomic.Int32
+	skew := 42 * time.Second
+	clock := NewClockSync(func(ctx context.Context) (int64, error) {
+		calls.Add(1)
+		time.Sleep(20 * time.Millisecond)
+		return time.Now().Add(skew).Unix(), nil
+	}, time.Hour)
+
+	now := clock.Now(context.Background())
+	if d := now.Sub(time.Now().Add(skew)); d < -time.Second || d > time.Second {
+		t.Fatalf("server time off by %s", d)
+	}
+	if d := clock.Offset() - skew; d < -time.Second || d > time.Second {
+		t.Fatalf("unexpected offset %s", clock.Offset())
+	}
+	for i := 0; i < 5; i++ {
+		clock.Now(context.Background())
+	}
+	if calls.Load() != 1 {
+		t.Fatalf("expected a single sync within the interval, got %d", calls.Load())
+	}
+	if synced, err := clock.Synced(); !synced || err != nil {
+		t.Fatalf("expected synced clock, got %v %v", synced, err)
+	}
+}
+
+func TestClockSync_Fallback(t *testing.T) {
+	fail := errors.New("unreachable")
+	clock := NewClockSync(func(ctx context.Context) (int64, error) {
+		return 0, fail
+	}, time.Hour)
+
+	if d := time.Since(clock.Now(context.Background())); d < -time.Second || d > time.Second {
+		t.Fatalf("expected local time, off by %s", d)
+	}
+	if synced, err := clock.Synced(); synced || !errors.Is(err, fail) {
+		t.Fatalf("expected failed sync, got %v %v", synced, err)
+	}
+	if err := clock.Sync(context.Background()); !errors.Is(err, fail) {
+		t.Fatalf("expected sync error, got %v", err)
+	}
+}
@@ -5,7 +5,7 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
-	"log"
+	"log/slog"
 	"strings"
 )
 
@@ -17,7 +17,7 @@ func BuildPolyHmacSignature(secret string, timestamp string, method string, requ
 
 	base64Secret, err := base64.URLEncoding.DecodeString(secret)
 	if err != nil {
-		log.Printf("secret decode  error: %v\n", err)
+		slog.Warn("hmac secret is not base64url encoded, using it as is")
 		base64Secret = []byte(secret)
 	}
 
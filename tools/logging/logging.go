@@ -0,0 +1,180 @@
+// Package logging provides the slog setup shared by the clients of this module:
+// every logger handed to a client is wrapped so that credentials, signatures and
+// private material never reach the output.
+package logging
+
+import (
+	"context"
+	"log"
+	"log/slog"
+	"net/http"
+	"strings"
+	"unicode"
+)
+
+// Redacted replaces the value of sensitive attributes.
+const Redacted = "[REDACTED]"
+
+// sensitiveWords name secret values. They are matched against the last word of a key, or
+// its last two words joined, so that privateKey and POLY_API_KEY are redacted but
+// signature_type is not.
+var sensitiveWords = map[string]bool{
+	"key": true, "creds": true, "credentials": true, "secret": true, "passphrase": true,
+	"signature": true, "private": true, "privatekey": true, "mnemonic": true, "password": true,
+	"apikey": true, "authorization": true, "cookie": true, "seed": true, "hmac": true,
+}
+
+// IsSensitiveKey reports whether values logged under key must be redacted.
+func IsSensitiveKey(key string) bool {
+	words := keyWords(key)
+	n := len(words)
+	if n == 0 {
+		return false
+	}
+	if last := words[n-1]; last != "key" {
+		return sensitiveWords[last]
+	}
+	// a bare key, an API key or a private key, not every key such as a cache key
+	return n == 1 || sensitiveWords[words[n-2]+"key"]
+}
+
+// keyWords splits key into lower cased words at '_', '-', '.' and camel case boundaries.
+func keyWords(key string) []string {
+	var words []string
+	var word []rune
+	flush := func() {
+		if len(word) > 0 {
+			words = append(words, strings.ToLower(string(word)))
+			word = word[:0]
+		}
+	}
+	runes := []rune(key)
+	for i, r := range runes {
+		if r == '_' || r == '-' || r == '.' || r == ' ' {
+			flush()
+			continue
+		}
+		// a word starts at an upper case letter after a lower case one (apiKey), or at the
+		// last upper case letter of an acronym followed by a lower case one (APIKey)
+		if unicode.IsUpper(r) && i > 0 && (!unicode.IsUpper(runes[i-1]) || i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+			flush()
+		}
+		word = append(word, r)
+	}
+	flush()
+	return words
+}
+
+// New returns a redacting logger for a client. A nil logger falls back to slog.Default().
+func New(logger *slog.Logger) *slog.Logger {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if _, ok := logger.Handler().(*redactHandler); ok {
+		return logger
+	}
+	return slog.New(NewRedactHandler(logger.Handler()))
+}
+
+// FromLogLogger returns a logger that writes records of at least level to l as text lines,
+// keeping the prefix and flags of l.
+func FromLogLogger(l *log.Logger, level slog.Leveler) *slog.Logger {
+	return slog.New(slog.NewTextHandler(logWriter{l}, &slog.HandlerOptions{
+		Level: level,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			// l prints its own time
+			if len(groups) == 0 && a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+}
+
+type logWriter struct {
+	l *log.Logger
+}
+
+func (w logWriter) Write(p []byte) (int, error) {
+	if err := w.l.Output(2, strings.TrimSuffix(string(p), "\n")); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Discard returns a logger that drops every record.
+func Discard() *slog.Logger {
+	return slog.New(slog.DiscardHandler)
+}
+
+// NewRedactHandler wraps h so that sensitive attributes are redacted before being handled.
+func NewRedactHandler(h slog.Handler) slog.Handler {
+	return &redactHandler{next: h}
+}
+
+type redactHandler struct {
+	next slog.Handler
+}
+
+func (h *redactHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *redactHandler) Handle(ctx context.Context, r slog.Record) error {
+	out := slog.NewRecord(r.Time, r.Level, r.Message, r.PC)
+	r.Attrs(func(a slog.Attr) bool {
+		out.AddAttrs(redactAttr(a))
+		return true
+	})
+	return h.next.Handle(ctx, out)
+}
+
+func (h *redactHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	redacted := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		redacted[i] = redactAttr(a)
+	}
+	return &redactHandler{next: h.next.WithAttrs(redacted)}
+}
+
+func (h *redactHandler) WithGroup(name string) slog.Handler {
+	return &redactHandler{next: h.next.WithGroup(name)}
+}
+
+func redactAttr(a slog.Attr) slog.Attr {
+	if IsSensitiveKey(a.Key) {
+		return slog.String(a.Key, Redacted)
+	}
+	v := a.Value.Resolve()
+	switch v.Kind() {
+	case slog.KindGroup:
+		group := v.Group()
+		redacted := make([]slog.Attr, len(group))
+		for i, ga := range group {
+			redacted[i] = redactAttr(ga)
+		}
+		return slog.Attr{Key: a.Key, Value: slog.GroupValue(redacted...)}
+	case slog.KindAny:
+		switch m := v.Any().(type) {
+		case http.Header:
+			out := make(http.Header, len(m))
+			for k, vals := range m {
+				if IsSensitiveKey(k) {
+					vals = []string{Redacted}
+				}
+				out[k] = vals
+			}
+			return slog.Any(a.Key, out)
+		case map[string]string:
+			out := make(map[string]string, len(m))
+			for k, val := range m {
+				if IsSensitiveKey(k) {
+					val = Redacted
+				}
+				out[k] = val
+			}
+			return slog.Any(a.Key, out)
+		}
+	}
+	return slog.Attr{Key: a.Key, Value: v}
+}
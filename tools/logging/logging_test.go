@@ -0,0 +1,90 @@
+package logging
+
+import (
+	"bytes"
+	"log"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/ybina/polymarket-go/client/types"
+)
+
+func TestRedactHandler(t *testing.T) {
+	var buf bytes.Buffer
+	logger := New(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+
+	creds := types.ApiKeyCreds{Key: "key-1", Secret: "secret-1", Passphrase: "pass-1"}
+	header := http.Header{"Poly_api_key": {"key-2"}, "Poly_passphrase": {"pass-2"}, "Content-Type": {"application/json"}}
+	l2 := types.L2PolyHeader{POLYAddress: "0xabc", POLYSignature: "sig-3", POLYAPIKey: "key-3", POLYPassphrase: "pass-3", POLYTimestamp: "1700000000"}
+
+	logger.With("privateKey", "pk-4").WithGroup("req").Debug("request",
+		"creds", creds,
+		"auth", creds,
+		"header", header,
+		"l2", l2,
+		slog.Group("nested", "POLY_SIGNATURE", "sig-5", "market", "0x1"),
+		"secret", "secret-6",
+	)
+
+	out := buf.String()
+	for _, leaked := range []string{"key-1", "secret-1", "pass-1", "key-2", "pass-2", "sig-3", "key-3", "pass-3", "pk-4", "sig-5", "secret-6"} {
+		if strings.Contains(out, leaked) {
+			t.Errorf("%s leaked: %s", leaked, out)
+		}
+	}
+	for _, kept := range []string{"application/json", "0xabc", "1700000000", "market=0x1"} {
+		if !strings.Contains(out, kept) {
+			t.Errorf("%s missing: %s", kept, out)
+		}
+	}
+}
+
+func TestIsSensitiveKey(t *testing.T) {
+	cases := []struct {
+		key  string
+		want bool
+	}{
+		{"signature", true},
+		{"POLY_SIGNATURE", true},
+		{"POLYSignature", true},
+		{"signature_type", false},
+		{"signatureType", false},
+		{"privateKey", true},
+		{"private_key", true},
+		{"POLY_API_KEY", true},
+		{"x-api-key", true},
+		{"APIKey", true},
+		{"key", true},
+		{"cache_key", false},
+		{"secret", true},
+		{"Set-Cookie", true},
+		{"Content-Type", false},
+		{"market", false},
+		{"token_id", false},
+	}
+	for _, tc := range cases {
+		if got := IsSensitiveKey(tc.key); got != tc.want {
+			t.Errorf("%s: got %v, want %v", tc.key, got, tc.want)
+		}
+	}
+}
+
+func TestFromLogLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := New(FromLogLogger(log.New(&buf, "ws: ", 0), slog.LevelInfo))
+	logger.Debug("dropped")
+	logger.Info("connected", "url", "wss://x", "secret", "s-1")
+	if out := buf.String(); out != "ws: level=INFO msg=connected url=wss://x secret=[REDACTED]\n" {
+		t.Fatalf("unexpected output %q", out)
+	}
+}
+
+func TestNew(t *testing.T) {
+	logger := New(nil)
+	if New(logger) != logger {
+		t.Fatal("redacting loggers must not be wrapped twice")
+	}
+	Discard().Info("dropped")
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"log/slog"
 	"strconv"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/tkhq/go-sdk/pkg/api/client/wallets"
 	"github.com/tkhq/go-sdk/pkg/api/models"
 	"github.com/tkhq/go-sdk/pkg/apikey"
+	"github.com/ybina/polymarket-go/tools/logging"
 	"github.com/ybina/polymarket-go/tools/utils"
 )
 
@@ -21,6 +23,17 @@ type Config struct {
 	PrivateKey   string `json:"privateKey"`
 	Organization string `json:"organization"`
 	WalletName   string `json:"masterWalletName"`
+	// Logger receives the client logs; nil uses slog.Default(). Credentials are redacted.
+	Logger *slog.Logger `json:"-"`
+}
+
+// LogValue keeps the API private key out of logs.
+func (c Config) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("publicKey", c.PubKey),
+		slog.String("organization", c.Organization),
+		slog.String("masterWalletName", c.WalletName),
+	)
 }
 
 type WalletInfo struct {
@@ -30,10 +43,15 @@ type WalletInfo struct {
 
 type Client struct {
 	client     *sdk.Client
+	logger     *slog.Logger
 	WalletName string
 	WalletId   string
 }
 
+func (c *Client) log() *slog.Logger {
+	return logging.New(c.logger)
+}
+
 func NewTurnKeyService(config Config) (turnkeyClient Client, err error) {
 
 	scheme := apikey.SchemeP256
@@ -53,11 +71,13 @@ func NewTurnKeyService(config Config) (turnkeyClient Client, err error) {
 	}
 	turnKeyClient.WalletName = config.WalletName
 	turnKeyClient.client = client
+	turnKeyClient.logger = logging.New(config.Logger)
 	walletId, err := turnKeyClient.TryCreateNewWallet(config.WalletName)
 	if err != nil {
 		return turnKeyClient, err
 	}
 	turnKeyClient.WalletId = walletId
+	turnKeyClient.log().Info("turnkey wallet ready", "wallet", config.WalletName, "wallet_id", walletId)
 
 	return turnKeyClient, nil
 }
@@ -137,6 +157,7 @@ func (c *Client) CreateNewWallet(walletName string) (walletId string, depositAdd
 	if len(resp.Payload.Activity.Result.CreateWalletResult.Addresses) <= 0 || resp.Payload.Activity.Result.CreateWalletResult.Addresses[0] == "" {
 		return "", "", errors.New("unexpected empty addresses")
 	}
+	c.log().Info("created turnkey wallet", "wallet", walletName, "address", resp.Payload.Activity.Result.CreateWalletResult.Addresses[0])
 	return *resp.Payload.Activity.Result.CreateWalletResult.WalletID, resp.Payload.Activity.Result.CreateWalletResult.Addresses[0], nil
 }
 
@@ -190,6 +211,7 @@ func (c *Client) CreateAccount(idx uint64) (string, error) {
 		resp.Payload.Activity.Result.CreateWalletAccountsResult.Addresses[0] == "" {
 		return "", fmt.Errorf("unexpected empty addresses")
 	}
+	c.log().Info("created turnkey account", "index", idx, "address", resp.Payload.Activity.Result.CreateWalletAccountsResult.Addresses[0])
 	return resp.Payload.Activity.Result.CreateWalletAccountsResult.Addresses[0], nil
 }
 
@@ -221,8 +243,10 @@ func (c *Client) Sign(userAccount string, unsignedMsg string) (signedMsg string,
 	signedResp, err := c.client.V0().Signing.SignRawPayload(pkParams, c.client.Authenticator)
 
 	if err != nil {
+		c.log().Warn("turnkey sign failed", "account", userAccount, "error", err)
 		return "", err
 	}
+	c.log().Debug("turnkey signed payload", "account", userAccount)
 	if signedResp.Payload == nil ||
 		signedResp.Payload.Activity == nil ||
 		signedResp.Payload.Activity.Result == nil ||